@@ -0,0 +1,131 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+// This function queues an action on a pet.
+// Flow:
+// 1. Build the enqueue activity message for the pet, action and item.
+// 2. Execute the transaction and return its reply.
+func enqueueActivity(t *testing.T, tf *cardinal.TestFixture, nickName string, action string, itemName string) (*msg.EnqueueActivityMsgReply, error) {
+	enqueueMsg := msg.EnqueueActivityMsg{
+		TargetNickname: nickName,
+		Action:         action,
+		ItemName:       itemName,
+	}
+	return executeTx[msg.EnqueueActivityMsgReply](t, tf, enqueueActivityMsgName, enqueueMsg, personaTag)
+}
+
+// endActivity makes the current activity of a pet end on the next tick.
+func endActivity(t *testing.T, tf *cardinal.TestFixture, nickName string) {
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, nickName)
+	assert.NoError(t, err)
+	activity, err := component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	activity.CountDown = 1
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, activity))
+	tf.DoTick()
+}
+
+// TestSystem_ActivityQueue_StartsNextAction tests that queued actions start one after the other as activities end.
+func TestSystem_ActivityQueue_StartsNextAction(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and an eating pet are created, and the player buys a toy and a sponge.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	assert.NoError(t, buyToy(t, tf, foodName))
+	assert.NoError(t, buyToy(t, tf, playToyName))
+	assert.NoError(t, buyToy(t, tf, bathToyName))
+	_, err := PetFeedAction(t, tf, petName, foodName)
+	assert.NoError(t, err)
+
+	// When:
+	// - Playing then bathing are queued.
+	reply, err := enqueueActivity(t, tf, petName, game.QueuePlay, playToyName)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, reply.Position)
+	reply, err = enqueueActivity(t, tf, petName, game.QueueBath, bathToyName)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, reply.Position)
+
+	// Then:
+	// - The pet plays once it has eaten.
+	endActivity(t, tf, petName)
+	queue, err := query.QueryPetQueue(wCtx, &query.PetQueueRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Equal(t, game.ActivityPlaying, queue.Activity.Activity)
+	assert.Len(t, queue.Queue, 1)
+	assert.Equal(t, game.QueueBath, queue.Queue[0].Action)
+
+	// - The pet has a bath once it has played, and the queue is empty.
+	endActivity(t, tf, petName)
+	queue, err = query.QueryPetQueue(wCtx, &query.PetQueueRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Equal(t, game.ActivityBathing, queue.Activity.Activity)
+	assert.Empty(t, queue.Queue)
+
+	// - Both items were consumed.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	_, err = player.GetItemIdByName(wCtx, playToyName)
+	assert.Error(t, err)
+	_, err = player.GetItemIdByName(wCtx, bathToyName)
+	assert.Error(t, err)
+}
+
+// TestSystem_ActivityQueue_LimitsAndClear tests that invalid actions are refused, that the queue has a maximum length
+// and that it can be cleared without stopping the current activity.
+func TestSystem_ActivityQueue_LimitsAndClear(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and a sleeping pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	_, err := executeTx[msg.SleepPetMsgReply](t, tf, sleepMsgName, msg.SleepPetMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+
+	// When / Then:
+	// - Unknown actions and items the player does not own are refused.
+	_, err = enqueueActivity(t, tf, petName, "dance", "")
+	assert.ErrorContains(t, err, "unknown action")
+	_, err = enqueueActivity(t, tf, petName, game.QueuePlay, playToyName)
+	assert.Error(t, err)
+
+	// - The queue holds at most `game.MaxActivityQueue` actions.
+	for i := 0; i < game.MaxActivityQueue; i++ {
+		_, err = enqueueActivity(t, tf, petName, game.QueueSleep, "")
+		assert.NoError(t, err)
+	}
+	_, err = enqueueActivity(t, tf, petName, game.QueueSleep, "")
+	assert.ErrorContains(t, err, "queue is full")
+
+	// - Clearing the queue drops every queued action, and the pet goes on sleeping.
+	cleared, err := executeTx[msg.ClearQueueMsgReply](t, tf, clearQueueMsgName, msg.ClearQueueMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, game.MaxActivityQueue, cleared.Cleared)
+	queue, err := query.QueryPetQueue(wCtx, &query.PetQueueRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Empty(t, queue.Queue)
+	assert.Equal(t, game.ActivitySleeping, queue.Activity.Activity)
+}
@@ -0,0 +1,223 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+// TestSystem_PetSleepAction_RestoresEnergyOverTime tests that a sleeping pet gets its energy back while it sleeps,
+// not when it falls asleep, and more slowly during the day.
+func TestSystem_PetSleepAction_RestoresEnergyOverTime(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a tired pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Energy{E: 30}))
+
+	// - The pet stays an egg for the test, so its energy does not decline.
+	pet, err := cardinal.GetComponent[component.Pet](wCtx, petId)
+	assert.NoError(t, err)
+	pet.BornTick = wCtx.CurrentTick() + game.TickHour
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, pet))
+
+	// When:
+	// - The pet is put to sleep.
+	reply, err := executeTx[msg.SleepPetMsgReply](t, tf, sleepMsgName, msg.SleepPetMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet sleeps for eight hours and has not got any energy back yet.
+	assert.Equal(t, game.ActivitySleeping, reply.Activity)
+	assert.Equal(t, game.TickEightHours, reply.Duration)
+	assert.Equal(t, 30, reply.Energy)
+	think, err := component.GetPetThink(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.ThinkSleep, think.Think)
+
+	// - The world starts in the morning, when a point of energy is restored every
+	//   `game.SleepEnergyTickRate` ticks only `game.DaySleepPercent` of the time.
+	for i := 0; i < game.SleepEnergyTickRate; i++ {
+		tf.DoTick()
+	}
+	energy, err := component.GetPetEnergy(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 30, energy.E)
+	dayTickRate := game.ActivityKinds[game.ActivitySleeping].TickRateAt(game.ClockAt(wCtx.CurrentTick()))
+	for i := game.SleepEnergyTickRate; i < dayTickRate; i++ {
+		tf.DoTick()
+	}
+	energy, err = component.GetPetEnergy(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 31, energy.E)
+}
+
+// TestSystem_ActivityDeclineSystem_CompletionEffects tests that the completion effects of an activity are applied
+// when it runs to its end, and that the pet is then free again.
+func TestSystem_ActivityDeclineSystem_CompletionEffects(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a pet are created, and the player buys food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	assert.NoError(t, buyToy(t, tf, foodName))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: 50}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Wellness{Wn: 50}))
+
+	// - The pet eats, and its meal is about to end.
+	_, err = PetFeedAction(t, tf, petName, foodName)
+	assert.NoError(t, err)
+	activity, err := component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.ActivityEating, activity.Activity)
+	activity.CountDown = 1
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, activity))
+
+	// When:
+	// - The meal ends.
+	tf.DoTick()
+
+	// Then:
+	// - The completion effects of eating are applied.
+	wellness, err := cardinal.GetComponent[component.Wellness](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 50+game.WellnessIncrease, wellness.Wn)
+
+	// - The pet is no longer engaged in an activity.
+	activity, err = component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.InitialActivity, activity.Activity)
+	assert.Zero(t, activity.CountDown)
+}
+
+// TestSystem_CancelActivityAction_WakeUpPenalty tests that waking a sleeping pet stops its sleep and makes it grumpy.
+func TestSystem_CancelActivityAction_WakeUpPenalty(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a sleeping pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Wellness{Wn: 50}))
+	_, err = executeTx[msg.SleepPetMsgReply](t, tf, sleepMsgName, msg.SleepPetMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+
+	// When:
+	// - The pet is woken up.
+	reply, err := executeTx[msg.CancelActivityMsgReply](t, tf, cancelActivityMsgName,
+		msg.CancelActivityMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The sleep stopped early and the pet lost the wake up penalty.
+	assert.Equal(t, game.ActivitySleeping, reply.Activity)
+	assert.Less(t, reply.Elapsed, game.TickEightHours)
+	assert.Equal(t, -game.WakeUpPenalty, reply.Wellness)
+	wellness, err := cardinal.GetComponent[component.Wellness](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 50-game.WakeUpPenalty, wellness.Wn)
+
+	// - The pet is free for another activity.
+	activity, err := component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.InitialActivity, activity.Activity)
+	_, err = executeTx[msg.SleepPetMsgReply](t, tf, sleepMsgName, msg.SleepPetMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+}
+
+// TestSystem_CancelActivityAction_ProratesItemEffects tests that a meal stopped halfway only gives half of the food's
+// effects, so that eating again cannot stack more than one full meal.
+func TestSystem_CancelActivityAction_ProratesItemEffects(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a hungry pet without traits are created, and the player buys food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	setPetPersonality(t, tf, petName)
+	assert.NoError(t, buyToy(t, tf, foodName))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: 10}))
+
+	// - The pet eats, and its meal is halfway through.
+	_, err = PetFeedAction(t, tf, petName, foodName)
+	assert.NoError(t, err)
+	activity, err := component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	activity.CountDown = activity.TotalTicks / 2
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, activity))
+
+	// When:
+	// - The meal is stopped.
+	reply, err := executeTx[msg.CancelActivityMsgReply](t, tf, cancelActivityMsgName,
+		msg.CancelActivityMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet only got half of the food's satiety.
+	half := catalogItem(t, foodName).Effect(game.StatSatiety) / 2
+	assert.Equal(t, activity.TotalTicks/2, reply.Elapsed)
+	assert.Equal(t, half, reply.Satiety)
+	hunger, err := component.GetPetHunger(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 10+half, hunger.Satiety)
+}
+
+// TestSystem_CancelActivityAction_Rejected tests that only the owner can stop an activity, and only a running one.
+func TestSystem_CancelActivityAction_Rejected(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - Two players are created, one of them with an idle pet.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	createPersona(t, tf, traderTag)
+	createPlayer(t, tf, traderTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+
+	// When / Then:
+	// - The idle pet has no activity to stop.
+	_, err := executeTx[msg.CancelActivityMsgReply](t, tf, cancelActivityMsgName,
+		msg.CancelActivityMsg{TargetNickname: petName}, personaTag)
+	assert.ErrorContains(t, err, "pet is not engaged in an activity")
+
+	// - Another player cannot wake the pet up.
+	_, err = executeTx[msg.SleepPetMsgReply](t, tf, sleepMsgName, msg.SleepPetMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+	_, err = executeTx[msg.CancelActivityMsgReply](t, tf, cancelActivityMsgName,
+		msg.CancelActivityMsg{TargetNickname: petName}, traderTag)
+	assert.Error(t, err)
+}
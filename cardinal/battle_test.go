@@ -0,0 +1,160 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+const rivalName = "Rival"
+
+// setPetKinds gives a pet a Magic element and a Skill.
+func setPetKinds(t *testing.T, tf *cardinal.TestFixture, nickName string, element string, skill string) {
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, nickName)
+	assert.NoError(t, err)
+	if _, err := cardinal.GetComponent[component.Magic](wCtx, petId); err != nil {
+		assert.NoError(t, cardinal.AddComponentTo[component.Magic](wCtx, petId))
+		assert.NoError(t, cardinal.AddComponentTo[component.Skill](wCtx, petId))
+	}
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Magic{Kind: element}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Skill{Kind: skill}))
+}
+
+// runBattle makes a water pet challenge a fire pet and ticks until the battle is over.
+func runBattle(t *testing.T, tf *cardinal.TestFixture) (types.EntityID, *query.BattleLogResponse) {
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	createPersona(t, tf, traderTag)
+	createPlayer(t, tf, traderTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	assert.NoError(t, createPet(t, tf, rivalName, traderTag))
+	setPetKinds(t, tf, petName, "water", "Force")
+	setPetKinds(t, tf, rivalName, "fire", "Force")
+
+	challenge, err := executeTx[msg.ChallengePetMsgReply](t, tf, challengeMsgName, msg.ChallengePetMsg{PetNickname: petName, OpponentNickname: rivalName}, personaTag)
+	assert.NoError(t, err)
+	_, err = executeTx[msg.AcceptChallengeMsgReply](t, tf, acceptChallengeMsgName, msg.AcceptChallengeMsg{BattleID: challenge.BattleID}, traderTag)
+	assert.NoError(t, err)
+
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+	for i := 0; i <= game.BattleMaxRounds*game.BattleTickRate; i++ {
+		tf.DoTick()
+		log, err := query.QueryBattleLog(wCtx, &query.BattleLogRequest{BattleID: challenge.BattleID})
+		assert.NoError(t, err)
+		if log.Status == component.BattleFinished {
+			return challenge.BattleID, log
+		}
+	}
+	t.Fatal("battle did not finish")
+	return 0, nil
+}
+
+// TestBattleDamage_Elements tests that elemental advantages scale battle damage.
+func TestBattleDamage_Elements(t *testing.T) {
+	water := game.Combatant{Element: "water", Stats: game.StatsForSkill("Force"), Energy: game.MaxEnergy}
+	fire := game.Combatant{Element: "fire", Stats: game.StatsForSkill("Force"), Energy: game.MaxEnergy}
+	earth := game.Combatant{Element: "earth", Stats: game.StatsForSkill("Force"), Energy: game.MaxEnergy}
+
+	neutral := game.BattleDamage(fire, earth)
+	assert.Greater(t, game.BattleDamage(water, fire), neutral)
+	assert.Less(t, game.BattleDamage(fire, water), neutral)
+
+	// A tired pet hits softer.
+	tired := water
+	tired.Energy = 0
+	assert.Less(t, game.BattleDamage(tired, fire), game.BattleDamage(water, fire))
+}
+
+// TestSystem_Battle_ElementAdvantageWins tests that a battle resolves over ticks in favour of the stronger element.
+func TestSystem_Battle_ElementAdvantageWins(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// When:
+	// - A water pet battles a fire pet with the same skill.
+	_, log := runBattle(t, tf)
+
+	// Then:
+	// - The water pet wins and every attack is logged.
+	assert.Equal(t, petName, log.Winner)
+	assert.NotEmpty(t, log.Log)
+	assert.Zero(t, log.OpponentHP)
+	assert.Equal(t, petName, log.Log[0].Attacker)
+
+	// - Both pets earned experience, the winner more.
+	winner, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	winnerId, err := winner.GetPetNickname(wCtx, petName)
+	assert.NoError(t, err)
+	winnerPet, err := cardinal.GetComponent[component.Pet](wCtx, winnerId)
+	assert.NoError(t, err)
+	_, loserPet, err := component.GetPetByNickname(wCtx, rivalName)
+	assert.NoError(t, err)
+	assert.Equal(t, loserPet.Level, winnerPet.Level)
+	assert.Greater(t, winnerPet.XP, loserPet.XP)
+	assert.Positive(t, loserPet.XP)
+
+	// - Both pets are free again.
+	activity, err := component.GetPetActivity(wCtx, winnerId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.InitialActivity, activity.Activity)
+}
+
+// TestSystem_Battle_Deterministic tests that the same battle replays identically.
+func TestSystem_Battle_Deterministic(t *testing.T) {
+	// Given:
+	// - A battle is fought in a first world.
+	first := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(first.World)
+	_, firstLog := runBattle(t, first)
+
+	// When:
+	// - The same battle is fought in a second world.
+	second := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(second.World)
+	_, secondLog := runBattle(t, second)
+
+	// Then:
+	// - Both battles have the same log and winner.
+	assert.Equal(t, firstLog.Log, secondLog.Log)
+	assert.Equal(t, firstLog.Winner, secondLog.Winner)
+}
+
+// TestSystem_Battle_FinishedBattlesExpire tests that a finished battle and its log are removed after
+// `game.BattleRetentionTicks`.
+func TestSystem_Battle_FinishedBattlesExpire(t *testing.T) {
+	// Given:
+	// - A battle was fought and its log can be queried.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+	battleId, log := runBattle(t, tf)
+	assert.NotEmpty(t, log.Log)
+	battle, err := component.GetBattle(wCtx, battleId)
+	assert.NoError(t, err)
+	assert.Equal(t, battle.FinishedTick+game.BattleRetentionTicks, battle.ExpiresTick)
+
+	// When:
+	// - The retention runs out.
+	battle.ExpiresTick = tf.World.CurrentTick()
+	assert.NoError(t, cardinal.SetComponent(wCtx, battleId, battle))
+	tf.DoTick()
+
+	// Then:
+	// - The battle is gone with its log.
+	_, err = component.GetBattle(wCtx, battleId)
+	assert.Error(t, err)
+	_, err = query.QueryBattleLog(wCtx, &query.BattleLogRequest{BattleID: battleId})
+	assert.Error(t, err)
+}
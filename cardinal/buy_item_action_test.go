@@ -0,0 +1,265 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+// TestSystem_BuyItemAction_BuyStack tests that buying several units stacks them and charges for each unit.
+func TestSystem_BuyItemAction_BuyStack(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona and player are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+
+	// When:
+	// - The player buys three units of food, then one more.
+	reply, err := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: foodName, Quantity: 3}, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, reply.Quantity)
+	assert.NoError(t, buyToy(t, tf, foodName))
+
+	// Then:
+	// - The player owns a single stack of four units and paid for each of them.
+	list, err := query.QueryPlayerItems(wCtx, &query.ItemListMsg{PersonaTag: personaTag})
+	assert.NoError(t, err)
+	assert.Len(t, list.ItemList, 1)
+	assert.Equal(t, foodName, list.ItemList[0].ItemName)
+	assert.Equal(t, 4, list.ItemList[0].Quantity)
+
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, game.PlayerInitialMoney-4*catalogItem(t, foodName).Price, player.Money)
+}
+
+// TestSystem_BuyItemAction_HugeQuantity tests that an order whose total price would overflow is refused,
+// instead of wrapping to a tiny price.
+func TestSystem_BuyItemAction_HugeQuantity(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona and player are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+
+	// When:
+	// - The player orders more units than a stack holds, including a quantity whose total price wraps around.
+	_, tooManyErr := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName,
+		msg.ButItemMsg{Name: foodName, Quantity: game.MaxStackQuantity + 1}, personaTag)
+	_, hugeErr := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName,
+		msg.ButItemMsg{Name: foodName, Quantity: 2066035336255469781}, personaTag)
+
+	// Then:
+	// - Both orders are refused, and the player kept their money and owns nothing.
+	assert.ErrorContains(t, tooManyErr, "invalid quantity")
+	assert.ErrorContains(t, hugeErr, "invalid quantity")
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, game.PlayerInitialMoney, player.Money)
+	assert.Empty(t, player.Stacks())
+
+	// - The total of an order is only computed when it fits.
+	_, err = game.Money(1000).Times(2066035336255469781)
+	assert.Error(t, err)
+	total, err := game.Money(1000).Times(game.MaxStackQuantity)
+	assert.NoError(t, err)
+	assert.Equal(t, game.Money(1000*game.MaxStackQuantity), total)
+}
+
+// TestSystem_BuyItemAction_UseOne tests that using an item only consumes one unit of its stack.
+func TestSystem_BuyItemAction_UseOne(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and pet are created, and the player buys two units of food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	_, err := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: foodName, Quantity: 2}, personaTag)
+	assert.NoError(t, err)
+
+	// When:
+	// - The pet eats once.
+	_, err = PetFeedAction(t, tf, petName, foodName)
+	assert.NoError(t, err)
+
+	// Then:
+	// - One unit is left.
+	list, err := query.QueryPlayerItems(wCtx, &query.ItemListMsg{PersonaTag: personaTag})
+	assert.NoError(t, err)
+	assert.Len(t, list.ItemList, 1)
+	assert.Equal(t, 1, list.ItemList[0].Quantity)
+}
+
+// TestSystem_BuyItemAction_MigratesLegacyItems tests that items saved in the legacy Items list are grouped and migrated.
+func TestSystem_BuyItemAction_MigratesLegacyItems(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona and player are created, and the player has two units of food saved the legacy way.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	itemId, err := component.FindItemByName(wCtx, foodName)
+	assert.NoError(t, err)
+	playerId, err := component.FindPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	player, err := cardinal.GetComponent[component.Player](wCtx, playerId)
+	assert.NoError(t, err)
+	player.Inventory = nil
+	player.Items = []types.EntityID{itemId, itemId}
+	assert.NoError(t, cardinal.SetComponent(wCtx, playerId, player))
+
+	// - The legacy items are listed as a single stack.
+	list, err := query.QueryPlayerItems(wCtx, &query.ItemListMsg{PersonaTag: personaTag})
+	assert.NoError(t, err)
+	assert.Len(t, list.ItemList, 1)
+	assert.Equal(t, 2, list.ItemList[0].Quantity)
+
+	// When:
+	// - The player buys one more unit.
+	reply, err := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: foodName}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The legacy items were migrated into the stack.
+	assert.Equal(t, 3, reply.Quantity)
+	player, err = cardinal.GetComponent[component.Player](wCtx, playerId)
+	assert.NoError(t, err)
+	assert.Empty(t, player.Items)
+	assert.Equal(t, []component.ItemStack{{ItemID: itemId, Quantity: 3}}, player.Inventory)
+}
+
+// TestSystem_SellItemAction_SellBack tests that selling an item returns it to its store for a share of its price.
+func TestSystem_SellItemAction_SellBack(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona and player are created, and the player buys two units of food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	_, err := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: foodName, Quantity: 2}, personaTag)
+	assert.NoError(t, err)
+
+	// When:
+	// - The player sells one unit back.
+	reply, err := executeTx[msg.SellItemMsgReply](t, tf, sellItemMsgName, msg.SellItemMsg{Name: foodName}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The player is paid the sell back share of the price and keeps one unit.
+	price := catalogItem(t, foodName).Price
+	earned := price * game.SellBackPercent / 100
+	assert.True(t, reply.Success)
+	assert.Equal(t, earned, reply.Earned)
+	assert.Equal(t, 1, reply.Quantity)
+
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, game.PlayerInitialMoney-2*price+earned, player.Money)
+
+	// - The sale is recorded in the ledger.
+	ledger, err := query.QueryPlayerLedger(wCtx, &query.PlayerLedgerRequest{PersonaTag: personaTag})
+	assert.NoError(t, err)
+	assert.Equal(t, game.LedgerSale, ledger.Entries[0].Reason)
+	assert.Equal(t, earned, ledger.Entries[0].Amount)
+}
+
+// TestSystem_SellItemAction_NotOwned tests that an item the player does not own cannot be sold.
+func TestSystem_SellItemAction_NotOwned(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona and player are created, and the player buys one unit of food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, buyToy(t, tf, foodName))
+
+	// When:
+	// - The player sells more units than owned, then an item never bought.
+	_, tooManyErr := executeTx[msg.SellItemMsgReply](t, tf, sellItemMsgName, msg.SellItemMsg{Name: foodName, Quantity: 2}, personaTag)
+	_, notOwnedErr := executeTx[msg.SellItemMsgReply](t, tf, sellItemMsgName, msg.SellItemMsg{Name: reviveItemName}, personaTag)
+
+	// Then:
+	// - Both sales are rejected and the inventory is unchanged.
+	assert.Error(t, tooManyErr)
+	assert.Error(t, notOwnedErr)
+	list, err := query.QueryPlayerItems(wCtx, &query.ItemListMsg{PersonaTag: personaTag})
+	assert.NoError(t, err)
+	assert.Len(t, list.ItemList, 1)
+	assert.Equal(t, 1, list.ItemList[0].Quantity)
+}
+
+// TestSystem_SellItemAction_HugeQuantity tests that a sale is capped at a stack, so a huge stack left by an older
+// world cannot mint money, and that the sell back price is computed without overflowing.
+func TestSystem_SellItemAction_HugeQuantity(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona and player are created, and the player holds a huge stack of food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, buyToy(t, tf, foodName))
+	playerID, err := component.FindPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	player, err := cardinal.GetComponent[component.Player](wCtx, playerID)
+	assert.NoError(t, err)
+	player.Inventory[0].Quantity = 1_000_000_000_000
+	assert.NoError(t, cardinal.SetComponent(wCtx, playerID, player))
+	money := player.Money
+
+	// When:
+	// - The player sells the whole stack, then just over the maximum.
+	_, hugeErr := executeTx[msg.SellItemMsgReply](t, tf, sellItemMsgName,
+		msg.SellItemMsg{Name: foodName, Quantity: 1_000_000_000_000}, personaTag)
+	_, overErr := executeTx[msg.SellItemMsgReply](t, tf, sellItemMsgName,
+		msg.SellItemMsg{Name: foodName, Quantity: game.MaxStackQuantity + 1}, personaTag)
+
+	// Then:
+	// - Both sales are refused and the player was paid nothing.
+	assert.ErrorContains(t, hugeErr, "invalid quantity")
+	assert.ErrorContains(t, overErr, "invalid quantity")
+	player, err = cardinal.GetComponent[component.Player](wCtx, playerID)
+	assert.NoError(t, err)
+	assert.Equal(t, money, player.Money)
+
+	// - A sale of a full stack is paid its share of the price.
+	reply, err := executeTx[msg.SellItemMsgReply](t, tf, sellItemMsgName,
+		msg.SellItemMsg{Name: foodName, Quantity: game.MaxStackQuantity}, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, catalogItem(t, foodName).Price*game.MaxStackQuantity*game.SellBackPercent/100, reply.Earned)
+
+	// - The share of the largest amount does not overflow.
+	assert.Equal(t, game.Money(math.MaxInt64/2), game.Money(math.MaxInt64).Percent(game.SellBackPercent))
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+// catalogItem returns the item with the given name from the item catalog.
+func catalogItem(t *testing.T, name string) game.CatalogItem {
+	catalog, err := game.Catalog()
+	assert.NoError(t, err)
+	item, ok := catalog.Item(name)
+	assert.True(t, ok, "item %s is not in the catalog", name)
+	return item
+}
+
+// TestCatalog_StoresStockEveryItem tests that the stores are stocked with every item of the catalog and its effects.
+func TestCatalog_StoresStockEveryItem(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized and the stores are spawned.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	tf.DoTick()
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	catalog, err := game.Catalog()
+	assert.NoError(t, err)
+
+	// Then:
+	// - Every catalog item exists with its price and effects.
+	for _, items := range [][]game.CatalogItem{catalog.Food, catalog.Care, catalog.Toys} {
+		for _, catalogItem := range items {
+			itemID := findItem(t, wCtx, catalogItem.Name)
+			item, err := cardinal.GetComponent[component.Item](wCtx, itemID)
+			assert.NoError(t, err)
+			assert.Equal(t, catalogItem.Price, item.Price)
+			assert.Equal(t, catalogItem.Effects, component.GetItemEffects(wCtx, itemID))
+		}
+	}
+
+	// - Food is sold by the food store and toys by the toy store.
+	store, err := component.FindStoreForItem(wCtx, findItem(t, wCtx, catalog.Food[0].Name))
+	assert.NoError(t, err)
+	assert.Equal(t, component.FoodStore{}.Name(), store)
+	store, err = component.FindStoreForItem(wCtx, findItem(t, wCtx, catalog.Toys[0].Name))
+	assert.NoError(t, err)
+	assert.Equal(t, component.ToyStore{}.Name(), store)
+}
+
+// TestCatalog_ParseRejectsInvalidCatalogs tests that the catalog schema rejects unknown fields, negative prices, unknown stats and invalid buffs.
+func TestCatalog_ParseRejectsInvalidCatalogs(t *testing.T) {
+	tests := []struct {
+		name    string
+		catalog string
+		err     string
+	}{
+		{
+			name:    "unknown field",
+			catalog: `{"food": [{"name": "Apple", "price": 1000, "colour": "red"}]}`,
+			err:     `unknown field "colour"`,
+		},
+		{
+			name:    "negative price",
+			catalog: `{"toys": [{"name": "Ball", "price": -1}]}`,
+			err:     "negative price",
+		},
+		{
+			name:    "unknown stat",
+			catalog: `{"care": [{"name": "Pill", "price": 1, "effects": [{"stat": "luck", "amount": 5}]}]}`,
+			err:     "unknown effect stat",
+		},
+		{
+			name:    "revive buff",
+			catalog: `{"care": [{"name": "Feather", "effects": [{"stat": "revive", "amount": 50, "duration": 10}]}]}`,
+			err:     "invalid revive buff duration",
+		},
+		{
+			name:    "duplicated item",
+			catalog: `{"food": [{"name": "Apple"}], "toys": [{"name": "Apple"}]}`,
+			err:     "listed twice",
+		},
+		{
+			name:    "unknown disease",
+			catalog: `{"care": [{"name": "Pill", "cures": ["Flu"]}]}`,
+			err:     `cures an unknown disease "Flu"`,
+		},
+		{
+			name:    "unknown disease class",
+			catalog: `{"care": [{"name": "Vaccine", "prevents": ["fungal"]}]}`,
+			err:     `prevents an unknown class of diseases "fungal"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := game.ParseCatalog([]byte(tt.catalog))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+
+	// - A valid catalog sums the effects on the same stat.
+	catalog, err := game.ParseCatalog([]byte(`{"food": [{"name": "Cake", "price": 0, "effects": [
+		{"stat": "satiety", "amount": 10}, {"stat": "satiety", "amount": 5}]}]}`))
+	assert.NoError(t, err)
+	cake, ok := catalog.Item("Cake")
+	assert.True(t, ok)
+	assert.Equal(t, 15, cake.Effect(game.StatSatiety))
+}
+
+// findItem returns the entity of the store item with the given name.
+func findItem(t *testing.T, wCtx cardinal.WorldContext, name string) types.EntityID {
+	itemID, err := component.FindItemByName(wCtx, name)
+	assert.NoError(t, err)
+	return itemID
+}
+
+// TestCatalog_SyncUpdatesExistingWorld tests that an edited catalog reaches the store items of a world that already has them.
+func TestCatalog_SyncUpdatesExistingWorld(t *testing.T) {
+	// Given:
+	// - A world was started with the item catalog, and a player bought food.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	_, err := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: foodName}, personaTag)
+	assert.NoError(t, err)
+	foodID := findItem(t, wCtx, foodName)
+
+	// - The catalog is edited: the food costs twice as much and cures a stomach bug, and a new food is sold.
+	catalog, err := game.Catalog()
+	assert.NoError(t, err)
+	edited := *catalog
+	edited.Food = append([]game.CatalogItem(nil), catalog.Food...)
+	for i := range edited.Food {
+		if edited.Food[i].Name == foodName {
+			edited.Food[i].Price *= 2
+			edited.Food[i].Cures = []string{game.DiseaseStomachBug}
+		}
+	}
+	edited.Food = append(edited.Food, game.CatalogItem{Name: "Pear", Price: game.Coin,
+		Effects: []game.ItemEffect{{Stat: game.StatSatiety, Amount: 10}}})
+	data, err := json.Marshal(edited)
+	assert.NoError(t, err)
+	parsed, err := game.ParseCatalog(data)
+	assert.NoError(t, err)
+	assert.NotEqual(t, catalog.Version, parsed.Version)
+
+	// When:
+	// - The world syncs with the edited catalog, as the catalog system does on the first tick of a restarted world.
+	assert.NoError(t, component.SyncCatalogItems(wCtx, parsed))
+
+	// Then:
+	// - The existing item has the new price, on the same entity the player owns.
+	assert.Equal(t, foodID, findItem(t, wCtx, foodName))
+	item, err := cardinal.GetComponent[component.Item](wCtx, foodID)
+	assert.NoError(t, err)
+	assert.Equal(t, 2*catalogItem(t, foodName).Price, item.Price)
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, player.ItemQuantity(foodID))
+	effects, err := cardinal.GetComponent[component.ItemEffects](wCtx, foodID)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{game.DiseaseStomachBug}, effects.Cures)
+	assert.True(t, effects.IsDrug())
+
+	// - The new item is sold by the food store.
+	store, err := component.FindStoreForItem(wCtx, findItem(t, wCtx, "Pear"))
+	assert.NoError(t, err)
+	assert.Equal(t, component.FoodStore{}.Name(), store)
+
+	// When:
+	// - The world runs again with the original catalog, as after a restart.
+	tf.DoTick()
+
+	// Then:
+	// - The food is back to its catalog price and the item that left the catalog is no longer sold.
+	item, err = cardinal.GetComponent[component.Item](wCtx, foodID)
+	assert.NoError(t, err)
+	assert.Equal(t, catalogItem(t, foodName).Price, item.Price)
+	_, err = component.FindStoreForItem(wCtx, findItem(t, wCtx, "Pear"))
+	assert.Error(t, err)
+
+	// - Nor can it be bought.
+	_, err = executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: "Pear"}, personaTag)
+	assert.Error(t, err)
+	player, err = component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.False(t, player.HasItem(findItem(t, wCtx, "Pear")))
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/game"
+	"tamagotchi/query"
+)
+
+// TestClock_PhasesOfTheDay tests that the time of day follows the ticks, with night running from
+// `game.NightStartHour` until `game.DayStartHour`.
+func TestClock_PhasesOfTheDay(t *testing.T) {
+	// Given:
+	// - The ticks since the world started at `game.ClockStartHour` on day 1.
+	untilHour := func(day int, hour int) uint64 {
+		return uint64((day-1)*game.TickDay + (hour-game.ClockStartHour)*game.TickHour)
+	}
+
+	// When / Then:
+	// - The world starts in the day.
+	clock := game.ClockAt(0)
+	assert.Equal(t, 1, clock.Day)
+	assert.Equal(t, game.ClockStartHour, clock.Hour)
+	assert.Equal(t, game.PhaseDay, clock.Phase)
+	assert.Equal(t, uint64((game.NightStartHour-game.ClockStartHour)*game.TickHour), clock.TicksToNextPhase)
+
+	// - Night falls at `game.NightStartHour` and lasts until `game.DayStartHour` the next day.
+	clock = game.ClockAt(untilHour(1, game.NightStartHour) + 30*game.TickMinute)
+	assert.True(t, clock.IsNight())
+	assert.Equal(t, 30, clock.Minute)
+	clock = game.ClockAt(untilHour(2, 2))
+	assert.True(t, clock.IsNight())
+	assert.Equal(t, 2, clock.Day)
+	assert.Equal(t, uint64((game.DayStartHour-2)*game.TickHour), clock.TicksToNextPhase)
+	clock = game.ClockAt(untilHour(2, game.DayStartHour))
+	assert.False(t, clock.IsNight())
+
+	// - Pets get sleepier at night, and sleep restores energy less often during the day.
+	night := game.ClockAt(untilHour(1, game.NightStartHour))
+	day := game.ClockAt(0)
+	assert.Equal(t, game.NightEnergyDeclinePercent, night.DeclinePercent(game.StatEnergy))
+	assert.Equal(t, 100, night.DeclinePercent(game.StatHygiene))
+	assert.Equal(t, 100, day.DeclinePercent(game.StatEnergy))
+	sleeping := game.ActivityKinds[game.ActivitySleeping]
+	assert.Equal(t, game.SleepEnergyTickRate, sleeping.TickRateAt(night))
+	assert.Equal(t, game.SleepEnergyTickRate*100/game.DaySleepPercent, sleeping.TickRateAt(day))
+}
+
+// TestQuery_WorldClock tests that the world-clock query returns the time of day of the current tick.
+func TestQuery_WorldClock(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized and a few ticks ran.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	tf.DoTick()
+	tf.DoTick()
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// When:
+	// - The world clock is queried.
+	clock, err := query.QueryWorldClock(wCtx, &query.WorldClockRequest{})
+	assert.NoError(t, err)
+
+	// Then:
+	// - It is the morning of the first day.
+	assert.Equal(t, wCtx.CurrentTick(), clock.Tick)
+	assert.Equal(t, 1, clock.Day)
+	assert.Equal(t, game.ClockStartHour, clock.Hour)
+	assert.Equal(t, game.PhaseDay, clock.Phase)
+	assert.False(t, clock.IsNight)
+}
@@ -6,14 +6,22 @@ import (
 
 	"pkg.world.dev/world-engine/cardinal"
 	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
 )
 
 // Activity represents a pet's activity, including the type, total ticks, countdown, and percentage.
+// The effects of each type of activity are declared in `game.ActivityKinds`. The action starting the activity may hold
+// back its own rewards until the activity ends, e.g. the effects of the food eaten, prorated when it is cancelled.
+// The money the pet earns while busy adds up on the activity and is paid to its owner when the activity stops.
 type Activity struct {
 	Activity   string
 	TotalTicks int
 	CountDown  int
 	Percentage int
+	Effects    []game.ItemEffect // Effects applied with the completion effects of the activity
+	XP         int64             // Experience earned when the activity ends (see `GrantActivityXP`)
+	Earned     game.Money        // Income earned so far, paid to the owner when the activity stops
 }
 
 /**
@@ -60,3 +68,200 @@ func GetPetActivity(world cardinal.WorldContext, petId types.EntityID) (*Activit
 	//                    If no error occurs, return the fetched activity component.
 	return petActivity, nil
 }
+
+/**
+ * StartPetActivity starts a timed activity on the pet.
+ *
+ * Code Flow:
+ * 1. Look the activity up in `game.ActivityKinds`.
+ * 2. Set the activity, counting down from its duration.
+ * 3. Set the pet's think when the activity has one (see `RecordPetThought`).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   name (string): The name of the activity, e.g. `game.ActivitySleeping`.
+ *
+ * Returns:
+ *   (*Activity, error): The activity started, and an error if the activity is unknown or the pet could not be updated.
+ */
+func StartPetActivity(world cardinal.WorldContext, petId types.EntityID, name string) (*Activity, error) {
+	return StartPetActivityWith(world, petId, name, nil, 0)
+}
+
+/**
+ * StartPetActivityWith starts a timed activity on the pet, holding back the rewards of the action starting it
+ * until the activity ends (see `ActivityRewards`).
+ *
+ * Code Flow:
+ * 1. Look the activity up in `game.ActivityKinds`.
+ * 2. Set the activity with its rewards, counting down from its duration.
+ * 3. Set the pet's think when the activity has one (see `RecordPetThought`).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   name (string): The name of the activity, e.g. `game.ActivityEating`.
+ *   effects ([]game.ItemEffect): The effects applied when the activity ends, e.g. the effects of the food eaten.
+ *   xp (int64): The experience earned when the activity ends.
+ *
+ * Returns:
+ *   (*Activity, error): The activity started, and an error if the activity is unknown or the pet could not be updated.
+ */
+func StartPetActivityWith(world cardinal.WorldContext, petId types.EntityID, name string, effects []game.ItemEffect, xp int64) (*Activity, error) {
+	// Step 1: Look the activity up
+	kind, ok := game.ActivityByName(name)
+	if !ok {
+		return nil, fmt.Errorf("failed to start activity [unknown activity %s]", name)
+	}
+
+	// Step 2: Set the activity
+	petActivity, err := GetPetActivity(world, petId)
+	if err != nil {
+		return nil, err
+	}
+	petActivity.Activity = kind.Name
+	petActivity.CountDown = kind.Duration
+	petActivity.TotalTicks = kind.Duration
+	petActivity.Percentage = 100
+	petActivity.Effects = effects
+	petActivity.XP = xp
+	petActivity.Earned = 0
+	if err := cardinal.SetComponent(world, petId, petActivity); err != nil {
+		return nil, fmt.Errorf("failed to start activity [set Activity]: %w", err)
+	}
+
+	// Step 3: Set the think
+	if kind.Think != "" {
+		if err := RecordPetThought(world, petId, game.ThoughtActivity, kind.Think); err != nil {
+			return nil, err
+		}
+	}
+	return petActivity, nil
+}
+
+/**
+ * StopPetActivity sets the pet back to no activity.
+ *
+ * Code Flow:
+ * 1. Pay the income the pet earned during the activity to its owner, recorded as one activity income entry
+ *    in the ledger (see `IncreasePlayerMoney`).
+ * 2. Clear the activity.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   petActivity (*Activity): The activity to stop.
+ *
+ * Returns:
+ *   error: An error if the owner could not be paid or the pet could not be updated.
+ */
+func StopPetActivity(world cardinal.WorldContext, petId types.EntityID, petActivity *Activity) error {
+	// Step 1: Pay the owner
+	if petActivity.Earned > 0 {
+		pet, err := cardinal.GetComponent[Pet](world, petId)
+		if err != nil {
+			return fmt.Errorf("failed to stop activity [get Pet]: %w", err)
+		}
+		playerID, err := FindPlayerByPersonaTag(world, pet.PersonaTag)
+		if err != nil {
+			return fmt.Errorf("failed to stop activity [find owner]: %w", err)
+		}
+		if err := IncreasePlayerMoney(world, playerID, petActivity.Earned, game.LedgerActivityIncome, pet.Nickname); err != nil {
+			return fmt.Errorf("failed to stop activity [pay owner]: %w", err)
+		}
+	}
+
+	// Step 2: Clear the activity
+	petActivity.Activity = game.InitialActivity
+	petActivity.CountDown = 0
+	petActivity.Percentage = 0
+	petActivity.TotalTicks = 0
+	petActivity.Effects = nil
+	petActivity.XP = 0
+	petActivity.Earned = 0
+	if err := cardinal.SetComponent(world, petId, petActivity); err != nil {
+		return fmt.Errorf("failed to stop activity [set Activity]: %w", err)
+	}
+	return nil
+}
+
+/**
+ * ActivityRewards returns the effects and experience an activity gives when it stops after `elapsed` ticks.
+ *
+ * Code Flow:
+ * 1. Gather the completion effects of the activity and the effects held back by the action starting it.
+ * 2. When the activity stopped before its end, prorate them and the experience to the ticks it ran
+ *    (see `game.ProrateEffects`), so that cancelling and starting again does not earn more than running to the end.
+ *
+ * Parameters:
+ *   activity (*Activity): The activity stopping.
+ *   kind (game.ActivityKind): The kind of the activity.
+ *   elapsed (int): The number of ticks the activity ran.
+ *
+ * Returns:
+ *   ([]game.ItemEffect, int64): The effects to apply and the experience earned.
+ */
+func ActivityRewards(activity *Activity, kind game.ActivityKind, elapsed int) ([]game.ItemEffect, int64) {
+	// Step 1: Gather the effects
+	effects := make([]game.ItemEffect, 0, len(kind.CompletionEffects)+len(activity.Effects))
+	effects = append(effects, kind.CompletionEffects...)
+	effects = append(effects, activity.Effects...)
+
+	// Step 2: Prorate an activity stopped early
+	xp := activity.XP
+	if elapsed < activity.TotalTicks {
+		effects = game.ProrateEffects(effects, elapsed, activity.TotalTicks)
+		xp = xp * int64(max(elapsed, 0)) / int64(activity.TotalTicks)
+	}
+	return effects, xp
+}
+
+/**
+ * GrantActivityXP grants the experience earned by an activity to what it trains:
+ * the pet's Skill for training, its Magic for practice, and the pet itself otherwise, e.g. for playing.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   name (string): The name of the activity.
+ *   xp (int64): The experience earned.
+ *
+ * Returns:
+ *   error: An error if the pet could not be updated.
+ */
+func GrantActivityXP(world cardinal.WorldContext, petId types.EntityID, name string, xp int64) error {
+	if xp <= 0 {
+		return nil
+	}
+	switch name {
+	case game.ActivityTraining:
+		skill, err := GetPetSkill(world, petId)
+		if err != nil {
+			return err
+		}
+		skill.AddXP(xp)
+		if err := cardinal.SetComponent(world, petId, skill); err != nil {
+			return fmt.Errorf("failed to grant experience [set Skill]: %w", err)
+		}
+	case game.ActivityPracticing:
+		magic, err := GetPetMagic(world, petId)
+		if err != nil {
+			return err
+		}
+		magic.AddXP(xp)
+		if err := cardinal.SetComponent(world, petId, magic); err != nil {
+			return fmt.Errorf("failed to grant experience [set Magic]: %w", err)
+		}
+	default:
+		pet, err := cardinal.GetComponent[Pet](world, petId)
+		if err != nil {
+			return fmt.Errorf("failed to grant experience [get Pet]: %w", err)
+		}
+		pet.AddXP(xp)
+		if err := cardinal.SetComponent(world, petId, pet); err != nil {
+			return fmt.Errorf("failed to grant experience [set Pet]: %w", err)
+		}
+	}
+	return nil
+}
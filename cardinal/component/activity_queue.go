@@ -0,0 +1,66 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+// QueuedActivity is an action waiting to start on a pet once it is free.
+type QueuedActivity struct {
+	// Action is what the pet will do, see the `game.Queue*` constants.
+	Action string `json:"action"`
+	// ItemName is the item used by the action, empty for sleep.
+	ItemName string `json:"item_name"`
+	// QueuedTick is the tick at which the action was queued.
+	QueuedTick uint64 `json:"queued_tick"`
+}
+
+/**
+ * ActivityQueue represents the actions waiting to start on a pet, oldest first.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It is added to a pet the first time an action is queued and emptied by the activity system as activities end.
+ */
+type ActivityQueue struct {
+	Pending []QueuedActivity `json:"pending"`
+}
+
+/**
+ * Name returns the name of the ActivityQueue component.
+ *
+ * Returns:
+ *   (string): The name of the ActivityQueue component.
+ */
+func (ActivityQueue) Name() string {
+	return "ActivityQueue"
+}
+
+/**
+ * GetPetQueue returns the activity queue of a pet, and false if nothing was ever queued on the pet.
+ */
+func GetPetQueue(world cardinal.WorldContext, petId types.EntityID) (*ActivityQueue, bool) {
+	queue, err := cardinal.GetComponent[ActivityQueue](world, petId)
+	if err != nil {
+		return nil, false
+	}
+	return queue, true
+}
+
+/**
+ * SetPetQueue updates the activity queue of a pet, adding the ActivityQueue component the first time.
+ */
+func SetPetQueue(world cardinal.WorldContext, petId types.EntityID, queue *ActivityQueue) error {
+	if _, ok := GetPetQueue(world, petId); !ok {
+		if err := cardinal.AddComponentTo[ActivityQueue](world, petId); err != nil {
+			return fmt.Errorf("failed to update queue [add ActivityQueue]: %w", err)
+		}
+	}
+	if err := cardinal.SetComponent(world, petId, queue); err != nil {
+		return fmt.Errorf("failed to update queue [set ActivityQueue]: %w", err)
+	}
+	return nil
+}
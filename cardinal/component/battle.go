@@ -0,0 +1,170 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+// Battle statuses
+const (
+	BattlePending  = "pending"
+	BattleFighting = "fighting"
+	BattleFinished = "finished"
+)
+
+/**
+ * Battle is a challenge between two pets. Each battle is its own entity, and its entity ID is the battle ID.
+ *
+ * Code Flow:
+ *   A challenge creates a pending battle. Accepting it starts the fight, which the battle system resolves
+ *   one round at a time. Finished battles are kept `game.BattleRetentionTicks` so their log (see `BattleLog`)
+ *   can be queried, then removed by the battle system.
+ */
+type Battle struct {
+	// Challenger and Opponent are the persona tags of the pets' owners.
+	Challenger string `json:"challenger"`
+	Opponent   string `json:"opponent"`
+	// ChallengerPet and OpponentPet are the fighting pets.
+	ChallengerPet types.EntityID `json:"challenger_pet"`
+	OpponentPet   types.EntityID `json:"opponent_pet"`
+	// ChallengerHP and OpponentHP are the battle health left, starting from each pet's Health.
+	ChallengerHP int `json:"challenger_hp"`
+	OpponentHP   int `json:"opponent_hp"`
+	// Status is one of `BattlePending`, `BattleFighting` or `BattleFinished`.
+	Status string `json:"status"`
+	// Winner is the winning pet, or 0 for a draw.
+	Winner types.EntityID `json:"winner"`
+	// Round is the number of rounds fought so far.
+	Round       int    `json:"round"`
+	CreatedTick uint64 `json:"created_tick"`
+	// ExpiresTick is when a pending challenge is dropped, or a finished battle is removed with its log.
+	ExpiresTick  uint64 `json:"expires_tick"`
+	StartedTick  uint64 `json:"started_tick"`
+	FinishedTick uint64 `json:"finished_tick"`
+}
+
+/**
+ * BattleLog is the log of a battle, one entry per attack. It is kept on the battle entity apart from the Battle,
+ * so the battle system does not read every log on every tick.
+ */
+type BattleLog struct {
+	Rounds []BattleRound `json:"rounds"`
+}
+
+// BattleRound is one attack of a battle. Both pets attack once per round.
+type BattleRound struct {
+	Round    int            `json:"round"`
+	Tick     uint64         `json:"tick"`
+	Attacker types.EntityID `json:"attacker"`
+	Defender types.EntityID `json:"defender"`
+	Damage   int            `json:"damage"`
+	// DefenderHP is the battle health of the defender after the attack.
+	DefenderHP int `json:"defender_hp"`
+}
+
+/**
+ * Name returns the name of the Battle component.
+ *
+ * Returns:
+ *   (string): The name of the Battle component.
+ */
+func (Battle) Name() string {
+	return "Battle"
+}
+
+/**
+ * Name returns the name of the BattleLog component.
+ *
+ * Returns:
+ *   (string): The name of the BattleLog component.
+ */
+func (BattleLog) Name() string {
+	return "BattleLog"
+}
+
+/**
+ * GetBattle retrieves a battle by its ID.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   battleID (types.EntityID): The ID of the battle.
+ *
+ * Returns:
+ *   (*Battle, error): The Battle component, and an error if there is no battle with that ID.
+ */
+func GetBattle(world cardinal.WorldContext, battleID types.EntityID) (*Battle, error) {
+	battle, err := cardinal.GetComponent[Battle](world, battleID)
+	if err != nil {
+		return nil, fmt.Errorf("battle %d not found", battleID)
+	}
+	return battle, nil
+}
+
+/**
+ * GetBattleLog retrieves the log of a battle, empty for battles that have no BattleLog component.
+ */
+func GetBattleLog(world cardinal.WorldContext, battleID types.EntityID) *BattleLog {
+	log, err := cardinal.GetComponent[BattleLog](world, battleID)
+	if err != nil {
+		return &BattleLog{}
+	}
+	return log
+}
+
+/**
+ * GetPetCombatant gathers what a pet brings to a battle round.
+ *
+ * Code Flow:
+ * 1. Fetch the Pet and Energy components.
+ * 2. Fetch the Magic and Skill components, leaving the element empty and using the default stats
+ *    for pets without them (e.g. founder pets).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (game.Combatant, error): The pet's combatant, and any error that occurs during the process.
+ */
+func GetPetCombatant(world cardinal.WorldContext, petId types.EntityID) (game.Combatant, error) {
+	pet, err := cardinal.GetComponent[Pet](world, petId)
+	if err != nil {
+		return game.Combatant{}, fmt.Errorf("failed to battle [get Pet]: %w", err)
+	}
+	energy, err := GetPetEnergy(world, petId)
+	if err != nil {
+		return game.Combatant{}, fmt.Errorf("failed to battle [get Energy]: %w", err)
+	}
+	combatant := game.Combatant{Stats: game.DefaultSkillStats, Level: pet.Level, Energy: energy.E}
+	if magic, err := cardinal.GetComponent[Magic](world, petId); err == nil {
+		combatant.Element = magic.Kind
+	}
+	if skill, err := cardinal.GetComponent[Skill](world, petId); err == nil {
+		combatant.Stats = game.StatsForSkill(skill.Kind)
+	}
+	return combatant, nil
+}
+
+/**
+ * IsPetBattling reports whether the pet is in a battle that has not finished, pending or fighting.
+ */
+func IsPetBattling(world cardinal.WorldContext, petId types.EntityID) bool {
+	battling := false
+	_ = cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[Battle]())).
+		Each(world, func(id types.EntityID) bool {
+			battle, err := cardinal.GetComponent[Battle](world, id)
+			if err != nil || battle.Status == BattleFinished {
+				return true
+			}
+			battling = battle.ChallengerPet == petId || battle.OpponentPet == petId
+			return !battling
+		})
+	return battling
+}
@@ -0,0 +1,115 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+// Buff is an effect applied to a pet every `game.BuffTickRate` ticks until it expires.
+type Buff struct {
+	// Source is what started the buff, usually an item name.
+	Source string `json:"source"`
+	// Stat is the pet stat changed by the buff (see the `game.Stat*` constants).
+	Stat string `json:"stat"`
+	// Amount is added to the stat on every buff cycle.
+	Amount int `json:"amount"`
+	// ExpiresTick is the tick at which the buff stops.
+	ExpiresTick uint64 `json:"expires_tick"`
+}
+
+/**
+ * Buffs represents the buffs currently active on a pet.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It is added to a pet the first time it gets a buff and applied by the buff system.
+ */
+type Buffs struct {
+	Active []Buff `json:"active"`
+}
+
+/**
+ * Name returns the name of the Buffs component.
+ *
+ * Returns:
+ *   (string): The name of the Buffs component.
+ */
+func (Buffs) Name() string {
+	return "Buffs"
+}
+
+/**
+ * GetPetBuffs returns the buffs of a pet, and false if the pet never had one.
+ */
+func GetPetBuffs(world cardinal.WorldContext, petId types.EntityID) (*Buffs, bool) {
+	buffs, err := cardinal.GetComponent[Buffs](world, petId)
+	if err != nil {
+		return nil, false
+	}
+	return buffs, true
+}
+
+/**
+ * AddPetBuffs starts buffs on a pet.
+ *
+ * Code Flow:
+ * 1. Return if there are no buffs to start.
+ * 2. Get the Buffs component of the pet, adding it if the pet never had a buff.
+ * 3. For each buff effect, refresh the expiry of the active buff with the same source and stat,
+ *    or add a new buff expiring Duration ticks from now.
+ * 4. Update the Buffs component.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   source (string): What the buffs come from, usually the item name.
+ *   effects ([]game.ItemEffect): The buff effects, effects without a Duration are ignored.
+ *
+ * Returns:
+ *   error: An error if the Buffs component could not be updated.
+ */
+func AddPetBuffs(world cardinal.WorldContext, petId types.EntityID, source string, effects []game.ItemEffect) error {
+	// Step 1: Return if there are no buffs to start
+	if len(effects) == 0 {
+		return nil
+	}
+
+	// Step 2: Get the Buffs component, adding it if needed
+	buffs, ok := GetPetBuffs(world, petId)
+	if !ok {
+		if err := cardinal.AddComponentTo[Buffs](world, petId); err != nil {
+			return fmt.Errorf("failed to add buffs [add Buffs]: %w", err)
+		}
+		buffs = &Buffs{}
+	}
+
+	// Step 3: Refresh or add each buff
+	for _, effect := range effects {
+		if !effect.IsBuff() {
+			continue
+		}
+		expires := world.CurrentTick() + uint64(effect.Duration)
+		refreshed := false
+		for i := range buffs.Active {
+			if buffs.Active[i].Source == source && buffs.Active[i].Stat == effect.Stat {
+				buffs.Active[i].Amount = effect.Amount
+				buffs.Active[i].ExpiresTick = expires
+				refreshed = true
+			}
+		}
+		if !refreshed {
+			buffs.Active = append(buffs.Active, Buff{Source: source, Stat: effect.Stat, Amount: effect.Amount, ExpiresTick: expires})
+		}
+	}
+
+	// Step 4: Update the Buffs component
+	if err := cardinal.SetComponent(world, petId, buffs); err != nil {
+		return fmt.Errorf("failed to add buffs [set Buffs]: %w", err)
+	}
+	return nil
+}
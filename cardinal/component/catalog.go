@@ -0,0 +1,165 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * CatalogVersion records the version of the item catalog the store items were last synced with (see `SyncCatalogItems`).
+ * There is one CatalogVersion per world, on its own entity.
+ */
+type CatalogVersion struct {
+	Version string `json:"version"`
+}
+
+/**
+ * Name returns the name of the CatalogVersion component.
+ *
+ * Returns:
+ *   (string): The name of the CatalogVersion component.
+ */
+func (CatalogVersion) Name() string {
+	return "CatalogVersion"
+}
+
+/**
+ * SyncCatalogItems brings the store items of the world in line with an item catalog, so that a catalog edited
+ * after the world was created reaches the items it already has.
+ *
+ * Code Flow:
+ * 1. Return if the world was already synced with this version of the catalog.
+ * 2. For each catalog item, update the kind, description, price and effects of its entity,
+ *    or create the entity when the item is new to the catalog.
+ * 3. Restock every store with the items of the catalog, in catalog order. Items that left the catalog are
+ *    no longer sold, but their entities are kept for the players owning them.
+ * 4. Record the version of the catalog.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   catalog (*game.ItemCatalog): The item catalog (see `game.Catalog`).
+ *
+ * Returns:
+ *   error: An error if an item or a store could not be updated.
+ */
+func SyncCatalogItems(world cardinal.WorldContext, catalog *game.ItemCatalog) error {
+	// Step 1: Check the version the world was synced with
+	versionID, version, found := getCatalogVersion(world)
+	if found && version.Version == catalog.Version {
+		return nil
+	}
+
+	// Step 2: Update or create the items
+	shelves := []struct {
+		kind  ItemKind
+		items []game.CatalogItem
+		ids   []types.EntityID
+	}{{kind: ItemFood, items: catalog.Food}, {kind: ItemCare, items: catalog.Care}, {kind: ItemToy, items: catalog.Toys}}
+	for i := range shelves {
+		for _, catalogItem := range shelves[i].items {
+			id, err := syncCatalogItem(world, shelves[i].kind, catalogItem)
+			if err != nil {
+				return err
+			}
+			shelves[i].ids = append(shelves[i].ids, id)
+		}
+	}
+
+	// Step 3: Restock the stores
+	if err := restockStore(world, func(s *FoodStore) *[]types.EntityID { return &s.Foods }, shelves[0].ids); err != nil {
+		return err
+	}
+	if err := restockStore(world, func(s *DrugStore) *[]types.EntityID { return &s.Drugs }, shelves[1].ids); err != nil {
+		return err
+	}
+	if err := restockStore(world, func(s *ToyStore) *[]types.EntityID { return &s.Toys }, shelves[2].ids); err != nil {
+		return err
+	}
+
+	// Step 4: Record the version
+	if !found {
+		if _, err := cardinal.Create(world, CatalogVersion{Version: catalog.Version}); err != nil {
+			return fmt.Errorf("failed to sync catalog [create CatalogVersion]: %w", err)
+		}
+		return nil
+	}
+	version.Version = catalog.Version
+	if err := cardinal.SetComponent(world, versionID, version); err != nil {
+		return fmt.Errorf("failed to sync catalog [set CatalogVersion]: %w", err)
+	}
+	return nil
+}
+
+// getCatalogVersion returns the CatalogVersion of the world, and false if the store items were never synced.
+func getCatalogVersion(world cardinal.WorldContext) (types.EntityID, *CatalogVersion, bool) {
+	var versionID types.EntityID
+	var version *CatalogVersion
+	_ = cardinal.NewSearch().Entity(filter.Exact(filter.Component[CatalogVersion]())).Each(world, func(id types.EntityID) bool {
+		c, err := cardinal.GetComponent[CatalogVersion](world, id)
+		if err != nil {
+			return true
+		}
+		versionID, version = id, c
+		return false
+	})
+	return versionID, version, version != nil
+}
+
+// syncCatalogItem updates the entity of a catalog item, creating it when the item is new to the catalog.
+func syncCatalogItem(world cardinal.WorldContext, kind ItemKind, catalogItem game.CatalogItem) (types.EntityID, error) {
+	id, found := lookupItem(world, catalogItem.Name)
+	if !found {
+		return CreateCatalogItem(world, kind, catalogItem)
+	}
+	item, err := cardinal.GetComponent[Item](world, id)
+	if err != nil {
+		return 0, fmt.Errorf("failed to sync item %s [get Item]: %w", catalogItem.Name, err)
+	}
+	item.Kind = kind.String()
+	item.Description = catalogItem.Description
+	item.Price = catalogItem.Price
+	if err := cardinal.SetComponent(world, id, item); err != nil {
+		return 0, fmt.Errorf("failed to sync item %s [set Item]: %w", catalogItem.Name, err)
+	}
+
+	// Items created before the catalog have no ItemEffects yet
+	if _, err := cardinal.GetComponent[ItemEffects](world, id); err != nil {
+		if err := cardinal.AddComponentTo[ItemEffects](world, id); err != nil {
+			return 0, fmt.Errorf("failed to sync item %s [add ItemEffects]: %w", catalogItem.Name, err)
+		}
+	}
+	effects := NewItemEffects(catalogItem)
+	if err := cardinal.SetComponent(world, id, &effects); err != nil {
+		return 0, fmt.Errorf("failed to sync item %s [set ItemEffects]: %w", catalogItem.Name, err)
+	}
+	return id, nil
+}
+
+// restockStore sets the items sold by every store of type T.
+func restockStore[T types.Component](world cardinal.WorldContext, items func(*T) *[]types.EntityID, ids []types.EntityID) error {
+	var storeIDs []types.EntityID
+	err := cardinal.NewSearch().Entity(filter.Contains(filter.Component[T]())).Each(world, func(id types.EntityID) bool {
+		storeIDs = append(storeIDs, id)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	for _, storeID := range storeIDs {
+		store, err := cardinal.GetComponent[T](world, storeID)
+		if err != nil {
+			return fmt.Errorf("failed to restock store: %w", err)
+		}
+		*items(store) = append([]types.EntityID(nil), ids...)
+		if err := cardinal.SetComponent(world, storeID, store); err != nil {
+			return fmt.Errorf("failed to restock store: %w", err)
+		}
+	}
+	return nil
+}
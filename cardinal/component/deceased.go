@@ -0,0 +1,76 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+/**
+ * Deceased marks a pet as dead. Deceased pets no longer accept actions and are skipped by the game mechanics.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It is added when the pet's health reaches zero and removed when the pet is revived.
+ */
+type Deceased struct {
+	// Cause is why the pet died (see the `game.Death*` constants).
+	Cause string `json:"cause"`
+	// DiedTick is the tick the pet died.
+	DiedTick uint64 `json:"died_tick"`
+}
+
+/**
+ * Name returns the name of the Deceased component.
+ *
+ * Returns:
+ *   (string): The name of the Deceased component.
+ */
+func (Deceased) Name() string {
+	return "Deceased"
+}
+
+/**
+ * GetPetDeceased retrieves the pet's Deceased component.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (*Deceased, bool): The Deceased component, and false if the pet is alive.
+ */
+func GetPetDeceased(world cardinal.WorldContext, petId types.EntityID) (*Deceased, bool) {
+	deceased, err := cardinal.GetComponent[Deceased](world, petId)
+	if err != nil {
+		return nil, false
+	}
+	return deceased, true
+}
+
+/**
+ * IsPetDeceased reports whether the pet is dead.
+ */
+func IsPetDeceased(world cardinal.WorldContext, petId types.EntityID) bool {
+	_, deceased := GetPetDeceased(world, petId)
+	return deceased
+}
+
+/**
+ * MarkPetDeceased adds the Deceased component to a pet.
+ *
+ * Code Flow:
+ * 1. Add the Deceased component to the pet entity.
+ * 2. Set the cause and tick of death.
+ */
+func MarkPetDeceased(world cardinal.WorldContext, petId types.EntityID, cause string) error {
+	if err := cardinal.AddComponentTo[Deceased](world, petId); err != nil {
+		return fmt.Errorf("failed to kill pet [add Deceased]: %w", err)
+	}
+	if err := cardinal.SetComponent(world, petId, &Deceased{Cause: cause, DiedTick: world.CurrentTick()}); err != nil {
+		return fmt.Errorf("failed to kill pet [set Deceased]: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,12 @@
 // Package component contains structures and functions for working with game components.
 package component
 
+import (
+	"math/rand"
+
+	"tamagotchi/game"
+)
+
 // Dna represents a DNA structure, including the counts of adenine (A), cytosine (C), guanine (G), and thymine (T).
 type Dna struct {
 	A int
@@ -9,6 +15,23 @@ type Dna struct {
 	T int
 }
 
+// GeneContribution describes how a single gene of a bred pet was derived from its parents.
+type GeneContribution struct {
+	// Gene is the gene letter (A, C, G or T).
+	Gene string `json:"gene"`
+	// Father is the percentage of the gene inherited from the father.
+	Father int `json:"father"`
+	// Mother is the percentage of the gene inherited from the mother.
+	Mother int `json:"mother"`
+	// Mutation is the drift applied to the gene after blending, 0 if it did not mutate.
+	Mutation int `json:"mutation"`
+	// Value is the resulting gene value of the child.
+	Value int `json:"value"`
+}
+
+// DnaGenes lists the gene letters in the order they are stored in Dna.
+var DnaGenes = []string{"A", "C", "G", "T"}
+
 /**
  * Name returns the name of the Dna component.
  *
@@ -27,3 +50,104 @@ func (Dna) Name() string {
 	//         This method is used to identify the component in the game world.
 	return "Dna"
 }
+
+/**
+ * Genes returns the genes of the Dna in the same order as DnaGenes.
+ *
+ * Returns:
+ *   ([]int): The A, C, G and T values.
+ */
+func (d Dna) Genes() []int {
+	return []int{d.A, d.C, d.G, d.T}
+}
+
+/**
+ * InheritDna derives a child Dna from the father's and mother's Dna.
+ *
+ * Code Flow:
+ * 1. For each gene, draw the father's share (0-100%) and blend both parents' values with it.
+ * 2. With `game.DnaMutationChance` percent probability, drift the blended value by up to `game.DnaMutationRange`.
+ * 3. Clamp the gene to the [0, game.DnaMaxGene) range.
+ * 4. Record the contribution of each parent so breeders can inspect the cross.
+ *
+ * Parameters:
+ *   rng (*rand.Rand): The deterministic world random source.
+ *   father (*Dna): The father's Dna.
+ *   mother (*Dna): The mother's Dna.
+ *
+ * Returns:
+ *   (Dna, []GeneContribution): The child Dna and the contribution of each parent per gene.
+ */
+func InheritDna(rng *rand.Rand, father *Dna, mother *Dna) (Dna, []GeneContribution) {
+	fatherGenes := father.Genes()
+	motherGenes := mother.Genes()
+	childGenes := make([]int, len(DnaGenes))
+	contributions := make([]GeneContribution, 0, len(DnaGenes))
+
+	for i, gene := range DnaGenes {
+		// Step 1: Blend both parents' genes
+		fatherShare := rng.Intn(101)
+		value := (fatherGenes[i]*fatherShare + motherGenes[i]*(100-fatherShare) + 50) / 100
+
+		// Step 2: Mutate the gene
+		mutation := 0
+		if rng.Intn(100) < game.DnaMutationChance {
+			mutation = rng.Intn(2*game.DnaMutationRange+1) - game.DnaMutationRange
+		}
+		value += mutation
+
+		// Step 3: Clamp the gene
+		if value < 0 {
+			value = 0
+		} else if value >= game.DnaMaxGene {
+			value = game.DnaMaxGene - 1
+		}
+		childGenes[i] = value
+
+		// Step 4: Record the contribution
+		contributions = append(contributions, GeneContribution{
+			Gene:     gene,
+			Father:   fatherShare,
+			Mother:   100 - fatherShare,
+			Mutation: mutation,
+			Value:    value,
+		})
+	}
+
+	return Dna{A: childGenes[0], C: childGenes[1], G: childGenes[2], T: childGenes[3]}, contributions
+}
+
+/**
+ * InheritKind picks a Magic or Skill kind for a child, biased by its parents' kinds.
+ *
+ * Code Flow:
+ * 1. Collect the parents' kinds that are set.
+ * 2. With `game.KindInheritChance` percent probability, pick one of them.
+ * 3. Otherwise (or if no parent has a kind), pick a random kind from the pool.
+ *
+ * Parameters:
+ *   rng (*rand.Rand): The deterministic world random source.
+ *   fatherKind (string): The father's kind, empty if he has none.
+ *   motherKind (string): The mother's kind, empty if she has none.
+ *   pool ([]string): All available kinds, e.g. `game.Elements` or `game.Skills`.
+ *
+ * Returns:
+ *   (string): The kind of the child.
+ */
+func InheritKind(rng *rand.Rand, fatherKind string, motherKind string, pool []string) string {
+	// Step 1: Collect the parents' kinds
+	inherited := make([]string, 0, 2)
+	for _, kind := range []string{fatherKind, motherKind} {
+		if kind != "" {
+			inherited = append(inherited, kind)
+		}
+	}
+
+	// Step 2: Keep one of the parents' kinds
+	if len(inherited) > 0 && rng.Intn(100) < game.KindInheritChance {
+		return inherited[rng.Intn(len(inherited))]
+	}
+
+	// Step 3: Pick a random kind
+	return pool[rng.Intn(len(pool))]
+}
@@ -36,9 +36,9 @@ func (DrugStore) Name() string {
  * InitDrugStore initializes the DrugStore component by creating and adding items to it.
  *
  * Code Flow:
- * 1. Iterate over the available drug kinds and their properties.
- * 2. For each drug kind, create a new item with the corresponding properties.
- * 3. Create a new entity for the item and add it to the DrugStore.
+ * 1. Load the item catalog (see `game.Catalog`).
+ * 2. For each care item of the catalog (drugs, bath items and revive items), create a new item entity with its effects.
+ * 3. Add the entity ID of the item to the DrugStore.
  * 4. Handle any errors that occur during the creation process.
  *
  * Parameters:
@@ -49,75 +49,29 @@ func (DrugStore) Name() string {
  */
 // InitDrugStore initializes the DrugStore component
 func (shop *DrugStore) InitDrugStore(world cardinal.WorldContext) {
-	// Step 1: Iterate over the available drug kinds and their properties
+	// Step 1: Load the item catalog
 	//         Get the logger from the world context to log any errors.
 	log := world.Logger()
-	var entityId types.EntityID
-	var err error
-	for drugName, properties := range game.DrugKinds {
-
-		// Step 2: For each drug kind, create a new item with the corresponding properties
-		//         Since we're iterating directly, we can use the current drugName
-		selectedDrug := drugName
-
-		// Step 2 (continued): Create a new item with the corresponding properties
-		//                    Create a new item with the selected drug name, kind, description, and price.
-		item := Item{
-			ItemName:    selectedDrug,
-			Kind:        ItemCare.String(),
-			Description: properties.Description,
-			Price:       properties.Price,
-		}
-
-		// Step 3: Create a new entity for the item and add it to the DrugStore
-		//         Use the Cardinal Create API to create a new entity for the item.
-		entityId, err = cardinal.Create(world, item,
-			Health{HP: properties.Value},
-		)
-
-		// Step 4: Handle any errors that occur during the creation process
-		//         If an error occurs during the creation process, log the error and return.
-		if err != nil {
-			log.Error().Msgf("Failed to create item %s: %v", item.ItemName, err)
-			return
-		}
-
-		// Step 3 (continued): Add the item to the DrugStore
-		//                    Append the entity ID of the item to the DrugStore's list of drugs.
-		shop.Drugs = append(shop.Drugs, entityId)
+	catalog, err := game.Catalog()
+	if err != nil {
+		log.Error().Msgf("Failed to load item catalog: %v", err)
+		return
 	}
 
-	for bathName, properties := range game.BathKinds {
-
-		// Step 2: For each drug kind, create a new item with the corresponding properties
-		//         Since we're iterating directly, we can use the current drugName
-		selectedDrug := bathName
-
-		// Step 2 (continued): Create a new item with the corresponding properties
-		//                    Create a new item with the selected drug name, kind, description, and price.
-		item := Item{
-			ItemName:    selectedDrug,
-			Kind:        ItemCare.String(),
-			Description: properties.Description,
-			Price:       properties.Price,
-		}
-
-		// Step 3: Create a new entity for the item and add it to the DrugStore
-		//         Use the Cardinal Create API to create a new entity for the item.
-		entityId, err = cardinal.Create(world, item,
-			Hygiene{Hy: properties.Value},
-		)
+	for _, care := range catalog.Care {
+		// Step 2: For each care item of the catalog, create a new item entity
+		//         Drugs affect health, bath items hygiene and revive items bring pets back.
+		entityId, err := CreateCatalogItem(world, ItemCare, care)
 
 		// Step 4: Handle any errors that occur during the creation process
 		//         If an error occurs during the creation process, log the error and return.
 		if err != nil {
-			log.Error().Msgf("Failed to create item %s: %v", item.ItemName, err)
+			log.Error().Msgf("Failed to create item %s: %v", care.Name, err)
 			return
 		}
 
-		// Step 3 (continued): Add the item to the DrugStore
-		//                    Append the entity ID of the item to the DrugStore's list of drugs.
+		// Step 3: Add the item to the DrugStore
+		//         Append the entity ID of the item to the DrugStore's list of drugs.
 		shop.Drugs = append(shop.Drugs, entityId)
 	}
-
 }
@@ -0,0 +1,175 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * Escrow is a bundle of items, money and pets taken from a player and held until a deal settles.
+ *
+ * Code Flow:
+ *   TakeEscrow removes the assets from a player, and ReleaseEscrow hands them to a player
+ *   (back to the same one when the deal is called off). Pets are held in the `game.ActivityEscrow`
+ *   activity meanwhile, which freezes their stats as nobody can look after them.
+ */
+type Escrow struct {
+	Items []ItemStack      `json:"items"`
+	Money game.Money       `json:"money"`
+	Pets  []types.EntityID `json:"pets"`
+}
+
+/**
+ * IsEmpty reports whether the escrow holds nothing.
+ */
+func (e Escrow) IsEmpty() bool {
+	return len(e.Items) == 0 && e.Money == 0 && len(e.Pets) == 0
+}
+
+/**
+ * TakeEscrow removes the assets from a player, all or nothing.
+ *
+ * Code Flow:
+ * 1. Retrieve the Player component and migrate its legacy inventory.
+ * 2. Check the player owns every item stack, has the money and owns every pet, and that the pets are free.
+ * 3. Remove the items, money and pets from the Player and save it.
+ * 4. Hold every pet in the `game.ActivityEscrow` activity.
+ * 5. Record the money taken in the Player's Ledger.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   playerID (types.EntityID): The ID of the player giving the assets.
+ *   assets (Escrow): The assets to take.
+ *   reason (string): The ledger reason of the money taken (see `game.Ledger*`).
+ *   reference (string): The ledger reference of the money taken.
+ *
+ * Returns:
+ *   error: An error if the player does not own all the assets.
+ */
+func TakeEscrow(world cardinal.WorldContext, playerID types.EntityID, assets Escrow, reason string, reference string) error {
+	if assets.Money < 0 {
+		return fmt.Errorf("error taking escrow: invalid amount %s", assets.Money)
+	}
+	player, err := cardinal.GetComponent[Player](world, playerID)
+	if err != nil {
+		return fmt.Errorf("error taking escrow [get Player]: %w", err)
+	}
+	player.MigrateInventory()
+
+	// Check everything first so a failure leaves the player untouched
+	if player.Money < assets.Money {
+		return fmt.Errorf("error taking escrow: no enough balance [%s] [%s]", player.Money, assets.Money)
+	}
+	seen := make(map[types.EntityID]bool, len(assets.Pets))
+	for _, petID := range assets.Pets {
+		if !player.HasPet(petID) || seen[petID] {
+			return fmt.Errorf("error taking escrow: pet %d does not belong to %s", petID, player.PersonaTag)
+		}
+		seen[petID] = true
+		activity, err := GetPetActivity(world, petID)
+		if err != nil {
+			return fmt.Errorf("error taking escrow: %w", err)
+		}
+		if activity.CountDown > 0 {
+			return fmt.Errorf("error taking escrow: pet %d is busy [%s]", petID, activity.Activity)
+		}
+	}
+	for _, stack := range assets.Items {
+		if stack.Quantity <= 0 {
+			return fmt.Errorf("error taking escrow: invalid quantity %d", stack.Quantity)
+		}
+		if err := player.removeStack(stack.ItemID, stack.Quantity); err != nil {
+			return fmt.Errorf("error taking escrow: %w", err)
+		}
+	}
+
+	for _, petID := range assets.Pets {
+		player.Pets = removeEntityID(player.Pets, petID)
+	}
+	player.Money -= assets.Money
+	if err := cardinal.SetComponent(world, playerID, player); err != nil {
+		return fmt.Errorf("error taking escrow [set Player]: %w", err)
+	}
+	for _, petID := range assets.Pets {
+		if _, err := StartPetActivity(world, petID, game.ActivityEscrow); err != nil {
+			return fmt.Errorf("error taking escrow: %w", err)
+		}
+	}
+	if assets.Money == 0 {
+		return nil
+	}
+	return RecordLedgerEntry(world, playerID, reason, reference, -assets.Money)
+}
+
+/**
+ * ReleaseEscrow gives the assets to a player.
+ *
+ * Code Flow:
+ * 1. Retrieve the Player component and migrate its legacy inventory.
+ * 2. Add the items, money and living pets to the Player and save it.
+ * 3. Stop holding every pet, and move it to the player's persona by rewriting `Pet.PersonaTag`.
+ *    Pets that died in escrow were already buried by their previous owner and stay there.
+ * 4. Record the money given in the Player's Ledger.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   playerID (types.EntityID): The ID of the player receiving the assets.
+ *   assets (Escrow): The assets to give.
+ *   reason (string): The ledger reason of the money given (see `game.Ledger*`).
+ *   reference (string): The ledger reference of the money given.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func ReleaseEscrow(world cardinal.WorldContext, playerID types.EntityID, assets Escrow, reason string, reference string) error {
+	player, err := cardinal.GetComponent[Player](world, playerID)
+	if err != nil {
+		return fmt.Errorf("error releasing escrow [get Player]: %w", err)
+	}
+	player.MigrateInventory()
+
+	for _, stack := range assets.Items {
+		player.addStack(stack.ItemID, stack.Quantity)
+	}
+	player.Money += assets.Money
+	for _, petID := range assets.Pets {
+		pet, err := cardinal.GetComponent[Pet](world, petID)
+		if err != nil {
+			return fmt.Errorf("error releasing escrow [get Pet]: %w", err)
+		}
+		if IsPetDeceased(world, petID) {
+			continue
+		}
+		if activity, err := GetPetActivity(world, petID); err == nil && activity.Activity == game.ActivityEscrow {
+			if err := StopPetActivity(world, petID, activity); err != nil {
+				return fmt.Errorf("error releasing escrow: %w", err)
+			}
+		}
+		if pet.PersonaTag != player.PersonaTag {
+			// The actions queued by the previous owner are not carried out for the new one
+			if queue, ok := GetPetQueue(world, petID); ok && len(queue.Pending) > 0 {
+				queue.Pending = nil
+				if err := SetPetQueue(world, petID, queue); err != nil {
+					return err
+				}
+			}
+		}
+		pet.PersonaTag = player.PersonaTag
+		if err := cardinal.SetComponent(world, petID, pet); err != nil {
+			return fmt.Errorf("error releasing escrow [set Pet]: %w", err)
+		}
+		player.Pets = append(player.Pets, petID)
+	}
+	if err := cardinal.SetComponent(world, playerID, player); err != nil {
+		return fmt.Errorf("error releasing escrow [set Player]: %w", err)
+	}
+	if assets.Money == 0 {
+		return nil
+	}
+	return RecordLedgerEntry(world, playerID, reason, reference, assets.Money)
+}
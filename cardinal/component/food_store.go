@@ -36,11 +36,10 @@ func (FoodStore) Name() string {
  * InitFoodStore initializes the FoodStore component by creating and adding food items to it.
  *
  * Code Flow:
- * 1. Iterate over the available food kinds and their properties.
- * 2. For each food kind, create a new item with the corresponding properties.
- * 3. Create a new entity for the item with the item and its components (Health and Energy).
- * 4. Add the entity ID of the item to the FoodStore's list of foods.
- * 5. Handle any errors that occur during the creation process.
+ * 1. Load the item catalog (see `game.Catalog`).
+ * 2. For each food item of the catalog, create a new item entity with its effects (e.g. health, energy and satiety).
+ * 3. Add the entity ID of the item to the FoodStore's list of foods.
+ * 4. Handle any errors that occur during the creation process.
  *
  * Parameters:
  *   world (cardinal.WorldContext): The world context.
@@ -49,44 +48,35 @@ func (FoodStore) Name() string {
  *   None
  *
  * Step-by-Step Explanation:
- *   Step 1: Iterate over the available food kinds and their properties. This is done using a range loop to access each food kind and its properties.
- *   Step 2: For each food kind, create a new item with the corresponding properties. This includes setting the item's name, kind, description, and price.
- *   Step 3: Create a new entity for the item with the item and its components. This involves using the cardinal.Create function to create a new entity with the item and its components (Health and Energy).
- *   Step 4: Add the entity ID of the item to the FoodStore's list of foods. This is done by appending the entity ID to the FoodStore's Foods slice.
- *   Step 5: Handle any errors that occur during the creation process. If an error occurs, log the error and return from the function.
+ *   Step 1: Load the item catalog. The catalog is read once at startup from catalog.json, or from the file named by `game.CatalogEnv`.
+ *   Step 2: For each food item of the catalog, create a new item entity. This involves using CreateCatalogItem to create the Item with its ItemEffects.
+ *   Step 3: Add the entity ID of the item to the FoodStore's list of foods. This is done by appending the entity ID to the FoodStore's Foods slice.
+ *   Step 4: Handle any errors that occur during the creation process. If an error occurs, log the error and return from the function.
  */
 // InitFoodStore initializes the FoodStore component
 func (shop *FoodStore) InitFoodStore(world cardinal.WorldContext) {
-	// Step 1: Iterate over the available food kinds and their properties
+	// Step 1: Load the item catalog
 	//         Get the logger from the world context to log any errors.
 	log := world.Logger()
+	catalog, err := game.Catalog()
+	if err != nil {
+		log.Error().Msgf("Failed to load item catalog: %v", err)
+		return
+	}
 
-	// Step 1 (continued): Iterate over the available food kinds and their properties
-	for foodName, properties := range game.FoodKinds {
-		// Step 2: For each food kind, create a new item with the corresponding properties
-		//         Create an item with the properties from the map.
-		item := Item{
-			ItemName:    foodName,
-			Kind:        ItemFood.String(),
-			Description: properties.Description,
-			Price:       properties.Price,
-		}
-
-		// Step 3: Create a new entity for the item with the item and its components
-		//         Create the entity with the item and its components (Health and Energy).
-		entityId, err := cardinal.Create(world, item,
-			Health{HP: properties.Health},
-			Energy{E: properties.Energy},
-		)
+	for _, food := range catalog.Food {
+		// Step 2: For each food item of the catalog, create a new item entity
+		//         Create the entity with the item and its effects.
+		entityId, err := CreateCatalogItem(world, ItemFood, food)
 
-		// Step 5: Handle any errors that occur during the creation process
+		// Step 4: Handle any errors that occur during the creation process
 		//         If an error occurs during the creation process, log the error and return.
 		if err != nil {
-			log.Error().Msgf("Failed to create item %s: %v", item.ItemName, err)
+			log.Error().Msgf("Failed to create item %s: %v", food.Name, err)
 			return
 		}
 
-		// Step 4: Add the entity ID of the item to the FoodStore's list of foods
+		// Step 3: Add the entity ID of the item to the FoodStore's list of foods
 		//         Append the entity ID of the item to the FoodStore's list of foods.
 		shop.Foods = append(shop.Foods, entityId)
 	}
@@ -0,0 +1,49 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+/**
+ * Hunger represents a component that stores how full a pet is. 100 means full, 0 means starving.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It declines in `HungerDeclineSystem` and is restored by feeding the pet. Food items carry it too,
+ *   holding the satiety they restore.
+ */
+type Hunger struct {
+	Satiety int `json:"satiety"`
+}
+
+/**
+ * Name returns the name of the Hunger component.
+ *
+ * Returns:
+ *   (string): The name of the Hunger component.
+ */
+func (Hunger) Name() string {
+	return "Hunger"
+}
+
+/**
+ * GetPetHunger retrieves the pet's hunger component.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (*Hunger, error): The pet's hunger component, and any error that occurs during the process.
+ */
+func GetPetHunger(world cardinal.WorldContext, petId types.EntityID) (*Hunger, error) {
+	hunger, err := cardinal.GetComponent[Hunger](world, petId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get [Hunger]: %w", err)
+	}
+	return hunger, nil
+}
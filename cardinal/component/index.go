@@ -0,0 +1,221 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+/**
+ * Index maps the unique names of the game to entity IDs, so finding a pet, a player or a store item
+ * by name does not scan every entity.
+ *
+ * Code Flow:
+ *   The world holds a single Index entity, created by `EnsureIndex`.
+ *   Entities are added with `IndexPet`, `IndexPlayer` and `IndexItem` when they are created,
+ *   and found with `lookupPet`, `lookupPlayer` and `lookupItem`.
+ */
+type Index struct {
+	// Pets maps pet nicknames to pet entities.
+	Pets map[string]types.EntityID `json:"pets"`
+	// Players maps persona tags to player entities.
+	Players map[string]types.EntityID `json:"players"`
+	// Items maps item names to store item entities.
+	Items map[string]types.EntityID `json:"items"`
+}
+
+/**
+ * Name returns the name of the Index component.
+ *
+ * Returns:
+ *   (string): The name of the Index component.
+ */
+func (Index) Name() string {
+	return "Index"
+}
+
+// getIndex returns the Index entity and component, and false if the world has no index yet.
+func getIndex(world cardinal.WorldContext) (types.EntityID, *Index, bool) {
+	var indexID types.EntityID
+	found := false
+	err := cardinal.NewSearch().Entity(filter.Exact(filter.Component[Index]())).Each(world, func(id types.EntityID) bool {
+		indexID = id
+		found = true
+		return false
+	})
+	if err != nil || !found {
+		return 0, nil, false
+	}
+	index, err := cardinal.GetComponent[Index](world, indexID)
+	if err != nil {
+		return 0, nil, false
+	}
+	return indexID, index, true
+}
+
+/**
+ * EnsureIndex creates the Index of the world when it does not have one.
+ *
+ * Code Flow:
+ * 1. Return if the world already has an Index.
+ * 2. Scan the pets, players and store items once to fill the index,
+ *    so worlds created before the index keep finding their entities.
+ * 3. Create the Index entity.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The game world context.
+ *
+ * Returns:
+ *   error: An error if the entities could not be scanned or the Index could not be created.
+ */
+func EnsureIndex(world cardinal.WorldContext) error {
+	// Step 1: Return if the world already has an Index
+	if _, _, ok := getIndex(world); ok {
+		return nil
+	}
+
+	// Step 2: Scan the existing entities
+	index := Index{
+		Pets:    make(map[string]types.EntityID),
+		Players: make(map[string]types.EntityID),
+		Items:   make(map[string]types.EntityID),
+	}
+	if err := scanIndex(world, index.Pets, func(c *Pet) string { return c.Nickname }); err != nil {
+		return err
+	}
+	if err := scanIndex(world, index.Players, func(c *Player) string { return c.PersonaTag }); err != nil {
+		return err
+	}
+	if err := scanIndex(world, index.Items, func(c *Item) string { return c.ItemName }); err != nil {
+		return err
+	}
+
+	// Step 3: Create the Index entity
+	if _, err := cardinal.Create(world, index); err != nil {
+		return fmt.Errorf("failed to create index: %w", err)
+	}
+	world.Logger().Info().Msgf("Created index of %d pets, %d players and %d items", len(index.Pets), len(index.Players), len(index.Items))
+	return nil
+}
+
+// scanIndex adds every entity with component T to entries, keyed by the name of the entity.
+func scanIndex[T types.Component](world cardinal.WorldContext, entries map[string]types.EntityID, name func(*T) string) error {
+	var zero T
+	err := cardinal.NewSearch().Entity(filter.Contains(filter.Component[T]())).Each(world, func(id types.EntityID) bool {
+		c, err := cardinal.GetComponent[T](world, id)
+		if err != nil {
+			return true
+		}
+		entries[name(c)] = id
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create index [scan %s]: %w", zero.Name(), err)
+	}
+	return nil
+}
+
+/**
+ * IndexPet adds a newly created pet to the Index.
+ */
+func IndexPet(world cardinal.WorldContext, nickname string, petID types.EntityID) error {
+	return updateIndex(world, func(index *Index) map[string]types.EntityID { return index.Pets }, nickname, petID)
+}
+
+/**
+ * IndexPlayer adds a newly created player to the Index.
+ */
+func IndexPlayer(world cardinal.WorldContext, personaTag string, playerID types.EntityID) error {
+	return updateIndex(world, func(index *Index) map[string]types.EntityID { return index.Players }, personaTag, playerID)
+}
+
+/**
+ * IndexItem adds a newly created store item to the Index.
+ */
+func IndexItem(world cardinal.WorldContext, itemName string, itemID types.EntityID) error {
+	return updateIndex(world, func(index *Index) map[string]types.EntityID { return index.Items }, itemName, itemID)
+}
+
+// updateIndex sets the entry of name in one of the maps of the Index.
+// Without an Index the entity is left to the scan of `EnsureIndex`.
+func updateIndex(world cardinal.WorldContext, entries func(*Index) map[string]types.EntityID, name string, id types.EntityID) error {
+	indexID, index, ok := getIndex(world)
+	if !ok {
+		return nil
+	}
+	if index.Pets == nil {
+		index.Pets = make(map[string]types.EntityID)
+	}
+	if index.Players == nil {
+		index.Players = make(map[string]types.EntityID)
+	}
+	if index.Items == nil {
+		index.Items = make(map[string]types.EntityID)
+	}
+	entries(index)[name] = id
+	if err := cardinal.SetComponent(world, indexID, index); err != nil {
+		return fmt.Errorf("failed to update index [set Index]: %w", err)
+	}
+	return nil
+}
+
+// lookupPet finds a pet by nickname, see `lookup`.
+func lookupPet(world cardinal.WorldContext, nickname string) (types.EntityID, bool) {
+	return lookup(world, func(index *Index) map[string]types.EntityID { return index.Pets }, nickname,
+		func(c *Pet) string { return c.Nickname })
+}
+
+// lookupPlayer finds a player by persona tag, see `lookup`.
+func lookupPlayer(world cardinal.WorldContext, personaTag string) (types.EntityID, bool) {
+	return lookup(world, func(index *Index) map[string]types.EntityID { return index.Players }, personaTag,
+		func(c *Player) string { return c.PersonaTag })
+}
+
+// lookupItem finds a store item by name, see `lookup`.
+func lookupItem(world cardinal.WorldContext, itemName string) (types.EntityID, bool) {
+	return lookup(world, func(index *Index) map[string]types.EntityID { return index.Items }, itemName,
+		func(c *Item) string { return c.ItemName })
+}
+
+/**
+ * lookup finds the entity with component T named name.
+ *
+ * Code Flow:
+ * 1. Read the entry of name in the Index, a missing entry means no such entity exists.
+ * 2. Check the entity still has that name.
+ * 3. Without an Index, or when the entry is stale, scan the entities with component T instead.
+ *
+ * Returns:
+ *   (types.EntityID, bool): The ID of the entity, and false if there is none.
+ */
+func lookup[T types.Component](world cardinal.WorldContext, entries func(*Index) map[string]types.EntityID, name string, nameOf func(*T) string) (types.EntityID, bool) {
+	// Step 1: Read the entry in the Index
+	if _, index, ok := getIndex(world); ok {
+		id, found := entries(index)[name]
+		if !found {
+			return 0, false
+		}
+
+		// Step 2: Check the entry
+		if c, err := cardinal.GetComponent[T](world, id); err == nil && nameOf(c) == name {
+			return id, true
+		}
+	}
+
+	// Step 3: Scan the entities
+	var entityID types.EntityID
+	found := false
+	_ = cardinal.NewSearch().Entity(filter.Contains(filter.Component[T]())).Each(world, func(id types.EntityID) bool {
+		c, err := cardinal.GetComponent[T](world, id)
+		if err != nil || nameOf(c) != name {
+			return true
+		}
+		entityID = id
+		found = true
+		return false
+	})
+	return entityID, found
+}
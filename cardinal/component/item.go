@@ -2,11 +2,13 @@
 package component
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"pkg.world.dev/world-engine/cardinal"
-	"pkg.world.dev/world-engine/cardinal/filter"
 	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
 )
 
 /**
@@ -30,9 +32,44 @@ type Item struct {
 	 */
 	Description string `json:"description"`
 	/**
-	 * Price is the cost of the item.
+	 * Price is the cost of the item in minor units (see `game.Money`).
+	 * Items saved before prices were in minor units are converted when they are read (see UnmarshalJSON).
 	 */
-	Price float64 `json:"price"`
+	Price game.Money `json:"price"`
+}
+
+// storedItem is the Item without its JSON methods.
+type storedItem Item
+
+/**
+ * MarshalJSON saves the item with the units of its price.
+ */
+func (i Item) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		storedItem
+		Units string `json:"units"`
+	}{storedItem(i), game.MoneyUnits})
+}
+
+/**
+ * UnmarshalJSON reads an item, converting the floating point price of items saved before prices were in minor units.
+ */
+func (i *Item) UnmarshalJSON(data []byte) error {
+	var stored struct {
+		storedItem
+		Price json.Number `json:"price"`
+		Units string      `json:"units"`
+	}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+	price, err := game.DecodeMoney(stored.Price, stored.Units)
+	if err != nil {
+		return fmt.Errorf("error reading item price: %w", err)
+	}
+	*i = Item(stored.storedItem)
+	i.Price = price
+	return nil
 }
 
 /**
@@ -108,11 +145,8 @@ func (itemKind ItemKind) String() string { // String method for nice printing
  * FindItemByName searches for an item with the given name and returns its EntityID.
  *
  * Code Flow:
- * 1. Create a new search query that searches for entities with the Item component.
- * 2. Iterate over the entities that match the search query.
- * 3. For each entity, check if the ItemName matches the given name.
- * 4. If a match is found, return the EntityID of the item.
- * 5. If no match is found, return an error.
+ * 1. Look the name up in the Index (see `lookup`).
+ * 2. If no match is found, return an error.
  *
  * Parameters:
  *   world (cardinal.WorldContext): The game world context.
@@ -122,43 +156,55 @@ func (itemKind ItemKind) String() string { // String method for nice printing
  *   (types.EntityID, error): The EntityID of the item if found, or an error if not found.
  *
  * Step-by-Step Explanation:
- *   Step 1: Create a new search query that searches for entities with the Item component.
- *   Step 2: Iterate over the entities that match the search query.
- *   Step 3: For each entity, check if the ItemName matches the given name.
- *   Step 4: If a match is found, return the EntityID of the item.
- *   Step 5: If no match is found, return an error.
+ *   Step 1: Look the name up in the Index, which maps every store item name to its EntityID.
+ *   Step 2: If no match is found, return an error.
  */
 func FindItemByName(world cardinal.WorldContext, itemName string) (types.EntityID, error) {
-	// Step 1: Create a new search query that searches for entities with the Item component
-	q := cardinal.NewSearch().Entity(
-		filter.Contains(filter.Component[Item]()),
-	)
-
-	var itemID types.EntityID
-	var found bool = false
-
-	// Step 2: Iterate over the entities that match the search query
-	q.Each(world, func(id types.EntityID) bool {
-		// Step 3: For each entity, check if the ItemName matches the given name
-		item, err := cardinal.GetComponent[Item](world, id)
-		if err != nil {
-			return true
-		}
-
-		if item.ItemName == itemName {
-			// Step 4: If a match is found, return the EntityID of the item
-			itemID = id
-			found = true
-			return false // Stop searching once we find the item
-		}
-
-		return true
-	})
-
-	// Step 5: If no match is found, return an error
+	// Step 1: Look the name up in the Index
+	itemID, found := lookupItem(world, itemName)
+
+	// Step 2: If no match is found, return an error
 	if !found {
 		return 0, fmt.Errorf("Item with name [%s] does not exist", itemName)
 	}
 
 	return itemID, nil
 }
+
+/**
+ * CreateCatalogItem creates the entity of a store item from the item catalog.
+ *
+ * Code Flow:
+ * 1. Build the Item component with the catalog name, description and price.
+ * 2. Create the entity with the Item and the ItemEffects of the catalog item.
+ * 3. Add the item to the Index, so it can be found by name.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The game world context.
+ *   kind (ItemKind): The kind of the item, e.g. ItemFood.
+ *   catalogItem (game.CatalogItem): The item as listed in the catalog.
+ *
+ * Returns:
+ *   (types.EntityID, error): The EntityID of the created item, or an error if the entity could not be created.
+ */
+func CreateCatalogItem(world cardinal.WorldContext, kind ItemKind, catalogItem game.CatalogItem) (types.EntityID, error) {
+	// Step 1: Build the Item component
+	item := Item{
+		ItemName:    catalogItem.Name,
+		Kind:        kind.String(),
+		Description: catalogItem.Description,
+		Price:       catalogItem.Price,
+	}
+
+	// Step 2: Create the entity with the Item and its effects
+	id, err := cardinal.Create(world, item, NewItemEffects(catalogItem))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create item %s: %w", catalogItem.Name, err)
+	}
+
+	// Step 3: Add the item to the Index
+	if err := IndexItem(world, catalogItem.Name, id); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
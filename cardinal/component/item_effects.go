@@ -0,0 +1,194 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * ItemEffects represents the effects a store item has on the pet using it.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It is created alongside the item from the item catalog and read by ApplyPetEffects, and by TreatPet for drugs.
+ */
+type ItemEffects struct {
+	// Effects are the stat changes and buffs of the item, see `game.ItemEffect`.
+	Effects []game.ItemEffect `json:"effects"`
+	// Cures are the diseases the item cures, see `game.Diseases`.
+	Cures []string `json:"cures,omitempty"`
+	// Prevents are the classes of diseases the pet is immune to after taking the item.
+	Prevents []string `json:"prevents,omitempty"`
+}
+
+/**
+ * NewItemEffects builds the effects of an item from its entry in the item catalog.
+ */
+func NewItemEffects(catalogItem game.CatalogItem) ItemEffects {
+	return ItemEffects{Effects: catalogItem.Effects, Cures: catalogItem.Cures, Prevents: catalogItem.Prevents}
+}
+
+/**
+ * IsDrug reports whether the item treats diseases.
+ */
+func (e ItemEffects) IsDrug() bool {
+	return len(e.Cures) > 0 || len(e.Prevents) > 0
+}
+
+/**
+ * Name returns the name of the ItemEffects component.
+ *
+ * Returns:
+ *   (string): The name of the ItemEffects component.
+ */
+func (ItemEffects) Name() string {
+	return "ItemEffects"
+}
+
+/**
+ * GetItemEffects returns the effects of a store item.
+ *
+ * Code Flow:
+ * 1. Return the ItemEffects component of the item when it has one.
+ * 2. Otherwise build the effects from the stat components of items created before the item catalog
+ *    (Health, Energy, Hygiene, Wellness, Hunger and Revive).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   itemID (types.EntityID): The ID of the item.
+ *
+ * Returns:
+ *   ([]game.ItemEffect): The effects of the item, empty if it has none.
+ */
+func GetItemEffects(world cardinal.WorldContext, itemID types.EntityID) []game.ItemEffect {
+	// Step 1: Return the ItemEffects component
+	if effects, err := cardinal.GetComponent[ItemEffects](world, itemID); err == nil {
+		return effects.Effects
+	}
+
+	// Step 2: Build the effects from the legacy stat components
+	var effects []game.ItemEffect
+	effects = appendLegacyEffect(world, itemID, effects, game.StatHealth, func(c *Health) int { return c.HP })
+	effects = appendLegacyEffect(world, itemID, effects, game.StatEnergy, func(c *Energy) int { return c.E })
+	effects = appendLegacyEffect(world, itemID, effects, game.StatHygiene, func(c *Hygiene) int { return c.Hy })
+	effects = appendLegacyEffect(world, itemID, effects, game.StatWellness, func(c *Wellness) int { return c.Wn })
+	effects = appendLegacyEffect(world, itemID, effects, game.StatSatiety, func(c *Hunger) int { return c.Satiety })
+	effects = appendLegacyEffect(world, itemID, effects, game.EffectRevive, func(c *Revive) int { return c.Value })
+	return effects
+}
+
+// appendLegacyEffect appends the effect of the legacy stat component T when the item has it.
+func appendLegacyEffect[T types.Component](world cardinal.WorldContext, itemID types.EntityID, effects []game.ItemEffect, stat string, value func(*T) int) []game.ItemEffect {
+	c, err := cardinal.GetComponent[T](world, itemID)
+	if err != nil {
+		return effects
+	}
+	return append(effects, game.ItemEffect{Stat: stat, Amount: value(c)})
+}
+
+/**
+ * HasEffectOn reports whether any of the effects changes the given stat.
+ */
+func HasEffectOn(effects []game.ItemEffect, stat string) bool {
+	for _, effect := range effects {
+		if effect.Stat == stat {
+			return true
+		}
+	}
+	return false
+}
+
+// StatDeltas holds how much each pet stat actually changed, after clamping to its range.
+type StatDeltas struct {
+	Health   int `json:"health"`
+	Energy   int `json:"energy"`
+	Hygiene  int `json:"hygiene"`
+	Wellness int `json:"wellness"`
+	Satiety  int `json:"satiety"`
+}
+
+/**
+ * ApplyPetEffects applies item and side effects to a pet.
+ *
+ * Code Flow:
+ * 1. Sum the instant effects on each stat, revive effects are left to the revive action.
+ * 2. Add every stat change to the pet, clamped between 0 and the stat maximum (`game.Max*`),
+ *    and record the actual change.
+ * 3. Start the buffs of the effects (see `AddPetBuffs`).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   source (string): What the effects come from, usually the item name, used to refresh buffs.
+ *   effects ([]game.ItemEffect): The effects to apply.
+ *
+ * Returns:
+ *   (StatDeltas, error): The actual change of each stat, and an error if a component could not be updated.
+ */
+func ApplyPetEffects(world cardinal.WorldContext, petId types.EntityID, source string, effects []game.ItemEffect) (StatDeltas, error) {
+	// Step 1: Sum the instant effects on each stat
+	amounts := make(map[string]int)
+	var buffs []game.ItemEffect
+	for _, effect := range effects {
+		switch {
+		case effect.Stat == game.EffectRevive:
+			continue
+		case effect.IsBuff():
+			buffs = append(buffs, effect)
+		default:
+			amounts[effect.Stat] += effect.Amount
+		}
+	}
+
+	// Step 2: Add every stat change, clamped to the stat range
+	var deltas StatDeltas
+	var err error
+	for _, stat := range game.ItemEffectStats {
+		amount, ok := amounts[stat]
+		if !ok || amount == 0 {
+			continue
+		}
+		switch stat {
+		case game.StatHealth:
+			deltas.Health, err = addPetStat(world, petId, func(c *Health) *int { return &c.HP }, game.MaxHP, amount)
+		case game.StatEnergy:
+			deltas.Energy, err = addPetStat(world, petId, func(c *Energy) *int { return &c.E }, game.MaxEnergy, amount)
+		case game.StatHygiene:
+			deltas.Hygiene, err = addPetStat(world, petId, func(c *Hygiene) *int { return &c.Hy }, game.MaxHygiene, amount)
+		case game.StatWellness:
+			deltas.Wellness, err = addPetStat(world, petId, func(c *Wellness) *int { return &c.Wn }, game.MaxWellness, amount)
+		case game.StatSatiety:
+			deltas.Satiety, err = addPetStat(world, petId, func(c *Hunger) *int { return &c.Satiety }, game.MaxSatiety, amount)
+		}
+		if err != nil {
+			return StatDeltas{}, err
+		}
+	}
+
+	// Step 3: Start the buffs
+	if err := AddPetBuffs(world, petId, source, buffs); err != nil {
+		return StatDeltas{}, err
+	}
+	return deltas, nil
+}
+
+// addPetStat adds amount to the stat of component T, clamped between 0 and maximum, and returns the actual change.
+func addPetStat[T types.Component](world cardinal.WorldContext, petId types.EntityID, stat func(*T) *int, maximum int, amount int) (int, error) {
+	var zero T
+	c, err := cardinal.GetComponent[T](world, petId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to apply effects [get %s]: %w", zero.Name(), err)
+	}
+	value := stat(c)
+	before := *value
+	*value = min(max(before+amount, 0), maximum)
+	if err := cardinal.SetComponent(world, petId, c); err != nil {
+		return 0, fmt.Errorf("failed to apply effects [set %s]: %w", zero.Name(), err)
+	}
+	return *value - before, nil
+}
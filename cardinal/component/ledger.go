@@ -0,0 +1,111 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * LedgerEntry is one debit or credit of a player's money.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   The income a pet earns during an activity is recorded as a single entry when the activity stops
+ *   (see `StopPetActivity`).
+ */
+type LedgerEntry struct {
+	// Seq is the position of the entry in the ledger, starting at 1.
+	Seq int `json:"seq"`
+	// Tick is the tick the entry was recorded.
+	Tick uint64 `json:"tick"`
+	// Reason is why the money moved (see the `game.Ledger*` constants).
+	Reason string `json:"reason"`
+	// Reference names what the money moved for, e.g. the item bought or the pet that earned it.
+	Reference string `json:"reference"`
+	// Amount is positive for credits and negative for debits.
+	Amount game.Money `json:"amount"`
+}
+
+/**
+ * Ledger is the append-only history of a player's money, attached to the player entity.
+ */
+type Ledger struct {
+	Entries []LedgerEntry `json:"entries"`
+}
+
+/**
+ * Name returns the name of the Ledger component.
+ *
+ * Returns:
+ *   (string): The name of the Ledger component.
+ */
+func (Ledger) Name() string {
+	return "Ledger"
+}
+
+/**
+ * GetPlayerLedger retrieves the player's ledger. Players without a Ledger component have an empty history.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   playerID (types.EntityID): The ID of the player.
+ *
+ * Returns:
+ *   (*Ledger): The player's ledger.
+ */
+func GetPlayerLedger(world cardinal.WorldContext, playerID types.EntityID) *Ledger {
+	ledger, err := cardinal.GetComponent[Ledger](world, playerID)
+	if err != nil {
+		return &Ledger{}
+	}
+	return ledger
+}
+
+/**
+ * RecordLedgerEntry appends a debit or credit to the player's ledger.
+ *
+ * Code Flow:
+ * 1. Retrieve the player's Ledger, adding the component to players created before ledgers existed.
+ * 2. Append a new entry. Past entries are never changed.
+ * 3. Update the Ledger component in the world.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   playerID (types.EntityID): The ID of the player.
+ *   reason (string): Why the money moved (see the `game.Ledger*` constants).
+ *   reference (string): What the money moved for.
+ *   amount (game.Money): Positive for credits, negative for debits.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func RecordLedgerEntry(world cardinal.WorldContext, playerID types.EntityID, reason string, reference string, amount game.Money) error {
+	// Step 1: Retrieve the player's Ledger
+	ledger, err := cardinal.GetComponent[Ledger](world, playerID)
+	if err != nil {
+		if err := cardinal.AddComponentTo[Ledger](world, playerID); err != nil {
+			return fmt.Errorf("failed to record ledger entry [add Ledger]: %w", err)
+		}
+		ledger = &Ledger{}
+	}
+
+	// Step 2: Append a new entry
+	ledger.Entries = append(ledger.Entries, LedgerEntry{
+		Seq:       len(ledger.Entries) + 1,
+		Tick:      world.CurrentTick(),
+		Reason:    reason,
+		Reference: reference,
+		Amount:    amount,
+	})
+
+	// Step 3: Update the Ledger component
+	if err := cardinal.SetComponent(world, playerID, ledger); err != nil {
+		return fmt.Errorf("failed to record ledger entry [set Ledger]: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * LifeStage represents the current life stage of a pet (egg, baby, child, teen, adult, elder).
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It is advanced by `LifeStageSystem` from the ticks elapsed since `Pet.BornTick`.
+ */
+type LifeStage struct {
+	// Stage is the name of the current stage, see `game.LifeStages`.
+	Stage string `json:"stage"`
+	// Since is the tick the pet entered the current stage.
+	Since uint64 `json:"since"`
+}
+
+/**
+ * Name returns the name of the LifeStage component.
+ *
+ * Returns:
+ *   (string): The name of the LifeStage component.
+ */
+func (LifeStage) Name() string {
+	return "LifeStage"
+}
+
+/**
+ * Properties returns the game properties (age threshold, decline rates) of the current stage.
+ */
+func (l LifeStage) Properties() game.StageProperties {
+	return game.StageByName(l.Stage)
+}
+
+/**
+ * GetPetLifeStage retrieves the pet's life stage component.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (*LifeStage, error): The pet's life stage component, and any error that occurs during the process.
+ */
+func GetPetLifeStage(world cardinal.WorldContext, petId types.EntityID) (*LifeStage, error) {
+	stage, err := cardinal.GetComponent[LifeStage](world, petId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get [LifeStage]: %w", err)
+	}
+	return stage, nil
+}
+
+/**
+ * PetAge returns the number of ticks elapsed since the pet was born.
+ */
+func PetAge(world cardinal.WorldContext, pet *Pet) uint64 {
+	if world.CurrentTick() < pet.BornTick {
+		return 0
+	}
+	return world.CurrentTick() - pet.BornTick
+}
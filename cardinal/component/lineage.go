@@ -0,0 +1,56 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+/**
+ * Lineage records the parents of a bred pet and how their Dna was combined.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It is attached by `PetBreedAction` and read by the `pet-lineage` query. Pets created with
+ *   `CreateRandomPet` are founders and do not have a Lineage.
+ */
+type Lineage struct {
+	// FatherID is the entity ID of the father.
+	FatherID types.EntityID `json:"father_id"`
+	// MotherID is the entity ID of the mother.
+	MotherID types.EntityID `json:"mother_id"`
+	// Father is the nickname of the father at breeding time.
+	Father string `json:"father"`
+	// Mother is the nickname of the mother at breeding time.
+	Mother string `json:"mother"`
+	// Genes is the contribution of each parent per gene.
+	Genes []GeneContribution `json:"genes"`
+}
+
+/**
+ * Name returns the name of the Lineage component.
+ *
+ * Returns:
+ *   (string): The name of the Lineage component.
+ */
+func (Lineage) Name() string {
+	return "Lineage"
+}
+
+/**
+ * GetPetLineage retrieves the pet's lineage component.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (*Lineage, bool): The pet's lineage, and false if the pet is a founder (no lineage).
+ */
+func GetPetLineage(world cardinal.WorldContext, petId types.EntityID) (*Lineage, bool) {
+	lineage, err := cardinal.GetComponent[Lineage](world, petId)
+	if err != nil {
+		return nil, false
+	}
+	return lineage, true
+}
@@ -0,0 +1,107 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+// ListingKindPet is the kind of listings selling a pet. Item listings use the `Item.Kind` of the item.
+const ListingKindPet = "Pet"
+
+/**
+ * Listing is a stack of items or a pet put up for sale on the marketplace. Each listing is its own
+ * entity, and its entity ID is the listing ID.
+ *
+ * Code Flow:
+ *   Listing takes the goods from the seller into escrow.
+ *   Buying the listing pays the seller (minus the market fee) and releases the goods to the buyer.
+ *   Letting it expire returns the goods to the seller.
+ */
+type Listing struct {
+	// Seller is the persona tag of the player selling the goods.
+	Seller string `json:"seller"`
+	// Goods holds the items or pet in escrow.
+	Goods Escrow `json:"goods"`
+	// Kind is the `Item.Kind` of the item, or `ListingKindPet`.
+	Kind string `json:"kind"`
+	// Label is the item name or the pet nickname.
+	Label string `json:"label"`
+	// Quantity is the number of units for sale, 1 for a pet.
+	Quantity int `json:"quantity"`
+	// Price is the price of the whole listing, in minor units.
+	Price game.Money `json:"price"`
+	// CreatedTick is the tick the listing was created.
+	CreatedTick uint64 `json:"created_tick"`
+	// ExpiresTick is the tick the goods are returned to the seller if they were not bought.
+	ExpiresTick uint64 `json:"expires_tick"`
+}
+
+/**
+ * Name returns the name of the Listing component.
+ *
+ * Returns:
+ *   (string): The name of the Listing component.
+ */
+func (Listing) Name() string {
+	return "Listing"
+}
+
+/**
+ * SellerProceeds returns what the seller receives once the market fee is taken, without overflowing for any price.
+ */
+func (l Listing) SellerProceeds() game.Money {
+	return l.Price - l.Price.Percent(game.MarketFeePercent)
+}
+
+/**
+ * GetListing retrieves a marketplace listing by its ID.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   listingID (types.EntityID): The ID of the listing.
+ *
+ * Returns:
+ *   (*Listing, error): The Listing component, and an error if there is no listing with that ID.
+ */
+func GetListing(world cardinal.WorldContext, listingID types.EntityID) (*Listing, error) {
+	listing, err := cardinal.GetComponent[Listing](world, listingID)
+	if err != nil {
+		return nil, fmt.Errorf("listing %d not found", listingID)
+	}
+	return listing, nil
+}
+
+/**
+ * CloseListing returns the goods to the seller and removes the listing.
+ *
+ * Code Flow:
+ * 1. Find the seller's player entity.
+ * 2. Release the escrowed goods back to the seller.
+ * 3. Remove the listing entity.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   listingID (types.EntityID): The ID of the listing.
+ *   listing (*Listing): The Listing component.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func CloseListing(world cardinal.WorldContext, listingID types.EntityID, listing *Listing) error {
+	sellerID, err := FindPlayerByPersonaTag(world, listing.Seller)
+	if err != nil {
+		return err
+	}
+	if err := ReleaseEscrow(world, sellerID, listing.Goods, game.LedgerRefund, fmt.Sprintf("listing %d", listingID)); err != nil {
+		return err
+	}
+	if err := cardinal.Remove(world, listingID); err != nil {
+		return fmt.Errorf("error closing listing [remove Listing]: %w", err)
+	}
+	return nil
+}
@@ -1,6 +1,15 @@
 // Package component contains structures and functions for working with game components.
 package component
 
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
 /**
  * Magic represents a magic component.
  *
@@ -47,3 +56,45 @@ func (Magic) Name() string {
 	//         This method is used to identify the component in the game world.
 	return "Magic"
 }
+
+/**
+ * NewMagic returns a level 0 Magic of the given element, on the XP curve of `CalculateNextLevelXP`.
+ */
+func NewMagic(kind string) Magic {
+	return Magic{Kind: kind, NextLevelXP: CalculateNextLevelXP(0, baseXP, growthRate)}
+}
+
+/**
+ * AddXP adds experience points to the magic, levelling it up on the same curve as pets (see `gainXP`).
+ */
+func (m *Magic) AddXP(xp int64) {
+	gainXP(&m.Level, &m.XP, &m.NextLevelXP, xp)
+}
+
+/**
+ * GetPetMagic retrieves the pet's Magic component.
+ *
+ * Code Flow:
+ * 1. Fetch the pet's Magic component.
+ * 2. Pets created before every pet had magic get a random element on first use.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (*Magic, error): The pet's Magic component, and any error that occurs during the process.
+ */
+func GetPetMagic(world cardinal.WorldContext, petId types.EntityID) (*Magic, error) {
+	if magic, err := cardinal.GetComponent[Magic](world, petId); err == nil {
+		return magic, nil
+	}
+	if err := cardinal.AddComponentTo[Magic](world, petId); err != nil {
+		return nil, fmt.Errorf("failed to get magic [add Magic]: %w", err)
+	}
+	magic := NewMagic(game.Elements[world.Rand().Intn(len(game.Elements))])
+	if err := cardinal.SetComponent(world, petId, &magic); err != nil {
+		return nil, fmt.Errorf("failed to get magic [set Magic]: %w", err)
+	}
+	return &magic, nil
+}
@@ -0,0 +1,73 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * Mood sums up how a pet is doing in a single value, e.g. for the face the frontend shows.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It is derived from the pet's stats every decline cycle by `MoodSystem` (see `game.MoodScore`).
+ */
+type Mood struct {
+	// Mood is the name of the mood, see `game.MoodLevels`.
+	Mood string `json:"mood"`
+	// Score is the weighted average of the pet's stats the mood was derived from.
+	Score int `json:"score"`
+	// Since is the tick the pet got into this mood.
+	Since uint64 `json:"since"`
+}
+
+/**
+ * Name returns the name of the Mood component.
+ *
+ * Returns:
+ *   (string): The name of the Mood component.
+ */
+func (Mood) Name() string {
+	return "Mood"
+}
+
+/**
+ * NewMood returns the mood of a pet with the given score, since the given tick.
+ */
+func NewMood(score int, tick uint64) Mood {
+	return Mood{Mood: game.MoodForScore(score).Name, Score: score, Since: tick}
+}
+
+/**
+ * Properties returns the game properties (experience and income percentages) of the mood.
+ */
+func (m Mood) Properties() game.MoodLevel {
+	return game.MoodByName(m.Mood)
+}
+
+/**
+ * GetPetMood retrieves the pet's mood.
+ *
+ * Returns:
+ *   (*Mood, bool): The mood of the pet, and false when its mood has not been computed yet.
+ */
+func GetPetMood(world cardinal.WorldContext, petId types.EntityID) (*Mood, bool) {
+	mood, err := cardinal.GetComponent[Mood](world, petId)
+	if err != nil {
+		return nil, false
+	}
+	return mood, true
+}
+
+/**
+ * PetMoodLevel returns the properties of the pet's mood, neutral until its mood has been computed.
+ */
+func PetMoodLevel(world cardinal.WorldContext, petId types.EntityID) game.MoodLevel {
+	if mood, ok := GetPetMood(world, petId); ok {
+		return mood.Properties()
+	}
+	return game.MoodByName(game.MoodNeutral)
+}
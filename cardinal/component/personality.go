@@ -0,0 +1,78 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"slices"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * Personality holds the personality traits of a pet, derived from its Dna when it is created or bred.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   Its traits change how fast the pet's stats decline, how much it gets from its care and what it thinks about
+ *   (see `game.PersonalityTraits`).
+ */
+type Personality struct {
+	// Traits are the names of the traits of the pet, see `game.PersonalityTraits`. Empty for an ordinary pet.
+	Traits []string `json:"traits"`
+}
+
+/**
+ * Name returns the name of the Personality component.
+ *
+ * Returns:
+ *   (string): The name of the Personality component.
+ */
+func (Personality) Name() string {
+	return "Personality"
+}
+
+/**
+ * NewPersonality derives the personality of a pet from its Dna: the trait of each gene at or above
+ * `game.TraitGeneThreshold`.
+ */
+func NewPersonality(dna Dna) Personality {
+	genes := dna.Genes()
+	traits := make([]string, 0)
+	for _, trait := range game.PersonalityTraits {
+		i := slices.Index(DnaGenes, trait.Gene)
+		if i >= 0 && genes[i] >= game.TraitGeneThreshold {
+			traits = append(traits, trait.Name)
+		}
+	}
+	return Personality{Traits: traits}
+}
+
+/**
+ * HasTrait reports whether the pet has the given trait.
+ */
+func (p Personality) HasTrait(trait string) bool {
+	return slices.Contains(p.Traits, trait)
+}
+
+/**
+ * GetPetTraits returns the personality traits of a pet. Pets created before personalities existed get
+ * theirs from their Dna, and pets without Dna have none.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   ([]string): The traits of the pet.
+ */
+func GetPetTraits(world cardinal.WorldContext, petId types.EntityID) []string {
+	if personality, err := cardinal.GetComponent[Personality](world, petId); err == nil {
+		return personality.Traits
+	}
+	if dna, err := cardinal.GetComponent[Dna](world, petId); err == nil {
+		return NewPersonality(*dna).Traits
+	}
+	return nil
+}
@@ -7,7 +7,6 @@ import (
 	"tamagotchi/game"
 
 	"pkg.world.dev/world-engine/cardinal"
-	"pkg.world.dev/world-engine/cardinal/filter"
 	"pkg.world.dev/world-engine/cardinal/types"
 )
 
@@ -96,10 +95,7 @@ func (Pet) Name() string {
  *   Step 3: If the pet has sufficient experience points, it calls the LevelUp method to advance the pet to the next level.
  */
 func (h *Pet) AddXP(xp int64) {
-	h.XP += xp
-	for h.XP >= h.NextLevelXP {
-		h.LevelUp()
-	}
+	gainXP(&h.Level, &h.XP, &h.NextLevelXP, xp)
 }
 
 /**
@@ -152,6 +148,26 @@ func CalculateNextLevelXP(level int64, baseXP int64, growthRate float64) int64 {
 	return nextLevelXP
 }
 
+/**
+ * gainXP adds experience points to a level, levelling up on the `CalculateNextLevelXP` curve while they reach the
+ * next level. Pets, skills and magic all level up this way.
+ *
+ * Code Flow:
+ *   Step 1: Compute the XP of the next level for components saved without it.
+ *   Step 2: Add the experience points and level up while they reach the next level, carrying over the excess.
+ */
+func gainXP(level *int64, xp *int64, nextLevelXP *int64, gained int64) {
+	if *nextLevelXP == 0 {
+		*nextLevelXP = CalculateNextLevelXP(*level, baseXP, growthRate)
+	}
+	*xp += gained
+	for *xp >= *nextLevelXP {
+		*level += 1
+		*xp -= *nextLevelXP
+		*nextLevelXP = CalculateNextLevelXP(*level, baseXP, growthRate)
+	}
+}
+
 /*
 *
 GetPetByNickname Function Flow:
@@ -174,41 +190,15 @@ func GetPetByNickname(world cardinal.WorldContext, nickname string) (types.Entit
 	return petId, pet, nil
 }
 
+/**
+ * QueryPetIdByName finds the pet with the given nickname in the Index (see `lookup`).
+ *
+ * Returns:
+ *   (bool, types.EntityID, error): Whether the pet exists, and its entity ID.
+ */
 func QueryPetIdByName(world cardinal.WorldContext, name string) (bool, types.EntityID, error) {
-	var petID types.EntityID
-	var err error
-	var found = false
-	log := world.Logger()
-
-	q := cardinal.NewSearch().Entity(
-		filter.Contains(filter.Component[Pet]()))
-
-	count, err := q.Count(world)
-	if count > 0 {
-		searchErr := q.Each(world,
-			func(id types.EntityID) bool {
-				var pet *Pet
-				pet, err = cardinal.GetComponent[Pet](world, id)
-				if err != nil {
-					return false
-				}
-
-				// Terminates the search if the pet is found
-				if pet.Nickname == name {
-					log.Info().Msgf("QueryPetIdByName Found it [%d]", petID)
-					petID = id
-					found = true
-					return false
-				}
-
-				// Continue searching if the pet is not the target pet
-				return true
-			})
-		if searchErr != nil {
-			return found, 0, fmt.Errorf("error searching pet: %w", err)
-		}
-	}
-	return found, petID, err
+	petID, found := lookupPet(world, name)
+	return found, petID, nil
 }
 
 /**
@@ -218,8 +208,8 @@ func QueryPetIdByName(world cardinal.WorldContext, name string) (bool, types.Ent
  *   Step 1: Create a new entity with the Pet component using the cardinal.Create method.
  *   Step 2: Initialize the pet's characteristics, such as PersonaTag, Nickname, Level, XP, and NextLevelXP.
  *   Step 3: Generate random values for the pet's Gender and other characteristics.
- *   Step 4: Add the pet's components, such as Health, Energy, Hygiene, Wellness, Dna, Activity, and Think.
- *   Step 5: Return the entity ID of the newly created pet.
+ *   Step 4: Add the pet's components, such as Health, Energy, Hygiene, Wellness, Hunger, Dna, Personality, Mood, Activity, Think, Magic, Skill, and LifeStage.
+ *   Step 5: Add the pet to the Index and return the entity ID of the newly created pet.
  *
  * Parameters:
  *   world (cardinal.WorldContext): The game world context.
@@ -233,27 +223,35 @@ func QueryPetIdByName(world cardinal.WorldContext, name string) (bool, types.Ent
  *   Step 1: This method creates a new entity with the Pet component using the cardinal.Create method.
  *   Step 2: It initializes the pet's characteristics, such as PersonaTag, Nickname, Level, XP, and NextLevelXP.
  *   Step 3: It generates random values for the pet's Gender and other characteristics.
- *   Step 4: It adds the pet's components, such as Health, Energy, Hygiene, Wellness, Dna, Activity, and Think.
- *   Step 5: It returns the entity ID of the newly created pet.
+ *   Step 4: It adds the pet's components, such as Health, Energy, Hygiene, Wellness, Hunger, Dna, Personality, Mood, Activity, Think, Thoughts, Magic, Skill, and LifeStage.
+ *   Step 5: It adds the pet to the Index, so it can be found by nickname, and returns the entity ID of the newly created pet.
  */
 func CreateRandomPet(world cardinal.WorldContext, personaTag string, nickname string) (types.EntityID, error) {
 	rng := world.Rand()
 	log := world.Logger()
 
+	dna := Dna{
+		A: rng.Intn(100),
+		C: rng.Intn(100),
+		G: rng.Intn(100),
+		T: rng.Intn(100),
+	}
 	petID, err := cardinal.Create(world,
 		Pet{PersonaTag: personaTag, Nickname: nickname, Level: 0, XP: 0, NextLevelXP: 0, Gender: rng.Intn(2) > 0, BornTick: world.CurrentTick()},
 		Health{HP: game.MaxHP},
 		Energy{E: game.MaxEnergy},
 		Hygiene{Hy: game.MaxHygiene},
 		Wellness{Wn: game.MaxWellness},
-		Dna{
-			A: rng.Intn(100),
-			C: rng.Intn(100),
-			G: rng.Intn(100),
-			T: rng.Intn(100),
-		},
+		Hunger{Satiety: game.MaxSatiety},
+		dna,
+		NewPersonality(dna),
+		NewMood(game.MaxMoodScore, world.CurrentTick()),
 		Activity{Activity: game.InitialActivity, CountDown: 0},
 		Think{Think: game.InitialThink},
+		Thoughts{History: make([]ThoughtEntry, 0)},
+		NewMagic(game.Elements[rng.Intn(len(game.Elements))]),
+		NewSkill(game.Skills[rng.Intn(len(game.Skills))]),
+		LifeStage{Stage: game.StageEgg, Since: world.CurrentTick()},
 	)
 	if err != nil {
 		log.Error().Msgf("Failed to create pet with nickname %s: %v", nickname, err)
@@ -261,5 +259,8 @@ func CreateRandomPet(world cardinal.WorldContext, personaTag string, nickname st
 	}
 	log.Info().Msgf("Created: Pet[%d] [%s]", petID, nickname)
 
+	if err := IndexPet(world, nickname, petID); err != nil {
+		return 0, err
+	}
 	return petID, nil
 }
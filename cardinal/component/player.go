@@ -2,19 +2,64 @@
 package component
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"pkg.world.dev/world-engine/cardinal"
-	"pkg.world.dev/world-engine/cardinal/filter"
 	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
 )
 
 // Player represents a player in the game.
 type Player struct {
 	PersonaTag string           `json:"personaTag"`
 	Pets       []types.EntityID `json:"pets"`
-	Items      []types.EntityID `json:"items"`
-	Money      float64          `json:"money"`
+	Graveyard  []types.EntityID `json:"graveyard"`
+	Inventory  []ItemStack      `json:"inventory"`
+	// Items is the legacy inventory, one store item ID per unit owned.
+	// Readers see it through Stacks, and MigrateInventory folds it into Inventory the next time the inventory changes.
+	Items []types.EntityID `json:"items"`
+	// Money is the balance in minor units (see `game.Money`).
+	// Players saved before balances were in minor units are converted when they are read (see UnmarshalJSON).
+	Money game.Money `json:"money"`
+}
+
+// storedPlayer is the Player without its JSON methods.
+type storedPlayer Player
+
+// MarshalJSON saves the Player with the units of its balance.
+func (p Player) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		storedPlayer
+		Units string `json:"units"`
+	}{storedPlayer(p), game.MoneyUnits})
+}
+
+// UnmarshalJSON reads a Player, converting the floating point balance of players saved before balances were in
+// minor units.
+func (p *Player) UnmarshalJSON(data []byte) error {
+	var stored struct {
+		storedPlayer
+		Money json.Number `json:"money"`
+		Units string      `json:"units"`
+	}
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return err
+	}
+	money, err := game.DecodeMoney(stored.Money, stored.Units)
+	if err != nil {
+		return fmt.Errorf("error reading player money: %w", err)
+	}
+	*p = Player(stored.storedPlayer)
+	p.Money = money
+	return nil
+}
+
+// ItemStack is a quantity of a store item (the item template) owned by a player.
+type ItemStack struct {
+	ItemID   types.EntityID `json:"item_id"`
+	Quantity int            `json:"quantity"`
 }
 
 // Name returns the name of the component.
@@ -62,33 +107,93 @@ func (p Player) GetPetNickname(world cardinal.WorldContext, nickname string) (ty
 	return 0, fmt.Errorf("pet not found")
 }
 
-// HasItem checks if the given EntityID exists in the Player's Items slice.
+// MigrateInventory moves the legacy Items of the Player into Inventory stacks.
 //
 // Code Flow:
-// 1. Iterate over the Player's Items slice.
-// 2. Check if the given EntityID matches any of the IDs in the slice.
-// 3. Return true if a match is found, false otherwise.
+// 1. Return false if there are no legacy Items.
+// 2. Add one unit to the matching stack for every legacy Item ID.
+// 3. Clear the legacy Items and return true.
+//
+// Returns true if the Player was modified and should be saved.
+func (p *Player) MigrateInventory() bool {
+	if len(p.Items) == 0 {
+		return false
+	}
+	p.Inventory = p.Stacks()
+	p.Items = nil
+	return true
+}
+
+// Stacks returns a copy of the Player's Inventory, including legacy Items that are not migrated yet.
+func (p Player) Stacks() []ItemStack {
+	migrated := Player{Inventory: make([]ItemStack, len(p.Inventory))}
+	copy(migrated.Inventory, p.Inventory)
+	for _, itemID := range p.Items {
+		migrated.addStack(itemID, 1)
+	}
+	return migrated.Inventory
+}
+
+// ItemQuantity returns how many units of the given item the Player owns.
+//
+// Code Flow:
+// 1. Find the stack of the item, counting legacy Items that are not migrated yet.
+// 2. Return its quantity, or 0 if the Player has none.
+func (p Player) ItemQuantity(id types.EntityID) int {
+	for _, stack := range p.Stacks() {
+		if stack.ItemID == id {
+			return stack.Quantity
+		}
+	}
+	return 0
+}
+
+// HasItem checks if the Player owns at least one unit of the given item.
 //
 // Returns true if the ItemId is found, false otherwise.
 func (p Player) HasItem(id types.EntityID) bool {
-	for _, itemID := range p.Items {
-		if itemID == id {
-			return true
+	return p.ItemQuantity(id) > 0
+}
+
+// addStack adds quantity units of an item, creating the stack if needed.
+func (p *Player) addStack(itemID types.EntityID, quantity int) {
+	for i := range p.Inventory {
+		if p.Inventory[i].ItemID == itemID {
+			p.Inventory[i].Quantity += quantity
+			return
 		}
 	}
-	return false
+	p.Inventory = append(p.Inventory, ItemStack{ItemID: itemID, Quantity: quantity})
+}
+
+// removeStack removes quantity units of an item, dropping the stack once empty.
+func (p *Player) removeStack(itemID types.EntityID, quantity int) error {
+	for i := range p.Inventory {
+		if p.Inventory[i].ItemID != itemID {
+			continue
+		}
+		if p.Inventory[i].Quantity < quantity {
+			return fmt.Errorf("not enough items [have %d, need %d]", p.Inventory[i].Quantity, quantity)
+		}
+		p.Inventory[i].Quantity -= quantity
+		if p.Inventory[i].Quantity == 0 {
+			p.Inventory = append(p.Inventory[:i], p.Inventory[i+1:]...)
+		}
+		return nil
+	}
+	return fmt.Errorf("item %d does not belong to the player", itemID)
 }
 
 // GetItemByName gets an Item with the specified name.
 //
 // Code Flow:
-// 1. Iterate over the Player's Items slice.
-// 2. For each Item, retrieve the Item component from the world.
+// 1. Iterate over the Player's Inventory stacks.
+// 2. For each stack, retrieve the Item component from the world.
 // 3. Check if the Item's name matches the specified name.
 // 4. Return the Item if a match is found, or an error if not.
 func (p Player) GetItemByName(world cardinal.WorldContext, itemName string) (*Item, error) {
-	for _, itemID := range p.Items {
-		item, err := cardinal.GetComponent[Item](world, itemID)
+	for _, stack := range p.Stacks() {
+		item, err := cardinal.GetComponent[Item](world, stack.ItemID)
 		if err != nil {
 			return nil, err
 		}
@@ -102,18 +207,18 @@ func (p Player) GetItemByName(world cardinal.WorldContext, itemName string) (*It
 // GetItemIdByName gets the EntityID of an Item with the specified name.
 //
 // Code Flow:
-// 1. Iterate over the Player's Items slice.
-// 2. For each Item, retrieve the Item component from the world.
+// 1. Iterate over the Player's Inventory stacks.
+// 2. For each stack, retrieve the Item component from the world.
 // 3. Check if the Item's name matches the specified name.
 // 4. Return the EntityID of the Item if a match is found, or an error if not.
 func (p Player) GetItemIdByName(world cardinal.WorldContext, itemName string) (types.EntityID, error) {
-	for _, itemID := range p.Items {
-		item, err := cardinal.GetComponent[Item](world, itemID)
+	for _, stack := range p.Stacks() {
+		item, err := cardinal.GetComponent[Item](world, stack.ItemID)
 		if err != nil {
-			return itemID, err
+			return stack.ItemID, err
 		}
 		if item.ItemName == itemName {
-			return itemID, nil
+			return stack.ItemID, nil
 		}
 	}
 	return 0, fmt.Errorf("item not found")
@@ -140,20 +245,87 @@ func AddPlayerPet(world cardinal.WorldContext, playerID types.EntityID, petID ty
 	return nil
 }
 
-// AddPlayerItem adds a new Item ID to the Player's Items array.
+// BuryPlayerPet moves a Pet ID from the Player's Pets array to the Player's Graveyard.
 //
 // Code Flow:
 // 1. Retrieve the Player component from the world.
-// 2. Append the new Item ID to the Player's Items array.
-// 3. Update the Player component in the world.
+// 2. Remove the Pet ID from the Player's Pets array.
+// 3. Append the Pet ID to the Player's Graveyard.
+// 4. Update the Player component in the world.
+func BuryPlayerPet(world cardinal.WorldContext, playerID types.EntityID, petID types.EntityID) error {
+	player, err := cardinal.GetComponent[Player](world, playerID)
+	if err != nil {
+		return fmt.Errorf("error burying pet: %w", err)
+	}
+
+	player.Pets = removeEntityID(player.Pets, petID)
+	player.Graveyard = append(player.Graveyard, petID)
+	err = cardinal.SetComponent(world, playerID, player)
+	if err != nil {
+		return fmt.Errorf("error updating player graveyard: %w", err)
+	}
+	return nil
+}
+
+// UnburyPlayerPet moves a Pet ID from the Player's Graveyard back to the Player's Pets array.
+//
+// Code Flow:
+// 1. Retrieve the Player component from the world.
+// 2. Check that the Pet ID is in the Player's Graveyard.
+// 3. Move the Pet ID from the Graveyard to the Pets array.
+// 4. Update the Player component in the world.
+func UnburyPlayerPet(world cardinal.WorldContext, playerID types.EntityID, petID types.EntityID) error {
+	player, err := cardinal.GetComponent[Player](world, playerID)
+	if err != nil {
+		return fmt.Errorf("error reviving pet: %w", err)
+	}
+
+	graveyard := removeEntityID(player.Graveyard, petID)
+	if len(graveyard) == len(player.Graveyard) {
+		return fmt.Errorf("pet %d is not in the player's graveyard", petID)
+	}
+	player.Graveyard = graveyard
+	player.Pets = append(player.Pets, petID)
+	err = cardinal.SetComponent(world, playerID, player)
+	if err != nil {
+		return fmt.Errorf("error updating player pets: %w", err)
+	}
+	return nil
+}
+
+// removeEntityID returns ids without the given id.
+func removeEntityID(ids []types.EntityID, id types.EntityID) []types.EntityID {
+	kept := make([]types.EntityID, 0, len(ids))
+	for _, existing := range ids {
+		if existing != id {
+			kept = append(kept, existing)
+		}
+	}
+	return kept
+}
+
+// AddPlayerItem adds one unit of an Item to the Player's Inventory.
 func AddPlayerItem(world cardinal.WorldContext, playerID types.EntityID, itemID types.EntityID) error {
-	// Append the new item to the player's Items array
+	return AddPlayerItems(world, playerID, itemID, 1)
+}
+
+// AddPlayerItems adds quantity units of an Item to the Player's Inventory.
+//
+// Code Flow:
+// 1. Retrieve the Player component from the world and migrate its legacy Items.
+// 2. Add the units to the Item's stack, creating it if needed.
+// 3. Update the Player component in the world.
+func AddPlayerItems(world cardinal.WorldContext, playerID types.EntityID, itemID types.EntityID, quantity int) error {
+	if quantity <= 0 {
+		return fmt.Errorf("error adding item to player: invalid quantity %d", quantity)
+	}
 	player, err := cardinal.GetComponent[Player](world, playerID)
 	if err != nil {
 		return fmt.Errorf("error adding item to player: %w", err)
 	}
 
-	player.Items = append(player.Items, itemID)
+	player.MigrateInventory()
+	player.addStack(itemID, quantity)
 	err = cardinal.SetComponent(world, playerID, player)
 	if err != nil {
 		return fmt.Errorf("error updating player items: %w", err)
@@ -161,82 +333,79 @@ func AddPlayerItem(world cardinal.WorldContext, playerID types.EntityID, itemID
 	return nil
 }
 
-// ReducePlayerMoney reduces the Player's money by a specified amount.
+// ReducePlayerMoney reduces the Player's money by a specified amount and records the debit in the Player's Ledger.
 //
 // Code Flow:
 // 1. Retrieve the Player component from the world.
-// 2. Subtract the specified amount from the Player's money.
+// 2. Subtract the specified amount from the Player's money, failing if the balance is too low.
 // 3. Update the Player component in the world.
-func ReducePlayerMoney(world cardinal.WorldContext, playerID types.EntityID, itemPrice float64) error {
-	// Append the new item to the player's Items array
+// 4. Record the debit with its reason in the Player's Ledger.
+func ReducePlayerMoney(world cardinal.WorldContext, playerID types.EntityID, amount game.Money, reason string, reference string) error {
+	if amount < 0 {
+		return fmt.Errorf("error reducing player money: invalid amount %s", amount)
+	}
 	player, err := cardinal.GetComponent[Player](world, playerID)
 	if err != nil {
 		return err
 	}
-	if player.Money-itemPrice < 0 {
-		return fmt.Errorf("error Buying, no enough balance [%f] [%f]", player.Money, itemPrice)
+	if player.Money-amount < 0 {
+		return fmt.Errorf("error Buying, no enough balance [%s] [%s]", player.Money, amount)
 	}
 
-	player.Money -= itemPrice
+	player.Money -= amount
 	err = cardinal.SetComponent(world, playerID, player)
 	if err != nil {
 		return fmt.Errorf("error updating player money: %w", err)
 	}
-	return nil
+	return RecordLedgerEntry(world, playerID, reason, reference, -amount)
 }
 
-// IncreasePlayerMoney increases the Player's money by a specified amount.
+// IncreasePlayerMoney increases the Player's money by a specified amount and records the credit in the Player's Ledger.
 //
 // Code Flow:
 // 1. Retrieve the Player component from the world.
 // 2. Add the specified amount to the Player's money.
 // 3. Update the Player component in the world.
-func IncreasePlayerMoney(world cardinal.WorldContext, playerID types.EntityID, quantity float64) error {
-	// Append the new item to the player's Items array
+// 4. Record the credit with its reason in the Player's Ledger.
+func IncreasePlayerMoney(world cardinal.WorldContext, playerID types.EntityID, amount game.Money, reason string, reference string) error {
+	if amount < 0 {
+		return fmt.Errorf("error increasing player money: invalid amount %s", amount)
+	}
 	player, err := cardinal.GetComponent[Player](world, playerID)
 	if err != nil {
-		return fmt.Errorf("error adding pet to player: %w", err)
+		return fmt.Errorf("error adding money to player: %w", err)
 	}
-
-	player.Money += quantity
+	player.Money += amount
 	err = cardinal.SetComponent(world, playerID, player)
 	if err != nil {
 		return fmt.Errorf("error updating player money: %w", err)
 	}
-	return nil
+	return RecordLedgerEntry(world, playerID, reason, reference, amount)
 }
 
-// RemoveItem removes an Item ID from the Player's Items array.
+// RemoveItem removes one unit of an Item from the Player's Inventory.
+func RemoveItem(world cardinal.WorldContext, playerID types.EntityID, itemID types.EntityID) error {
+	return RemovePlayerItems(world, playerID, itemID, 1)
+}
+
+// RemovePlayerItems removes quantity units of an Item from the Player's Inventory.
 //
 // Code Flow:
-// 1. Retrieve the Player component from the world.
-// 2. Iterate over the Player's Items array and remove the specified Item ID.
+// 1. Retrieve the Player component from the world and migrate its legacy Items.
+// 2. Remove the units from the Item's stack, failing if the Player owns fewer.
 // 3. Update the Player component in the world.
-func RemoveItem(world cardinal.WorldContext, playerID types.EntityID, itemID types.EntityID) error {
-	// Retrieve the player component
+func RemovePlayerItems(world cardinal.WorldContext, playerID types.EntityID, itemID types.EntityID, quantity int) error {
+	if quantity <= 0 {
+		return fmt.Errorf("error removing item from player: invalid quantity %d", quantity)
+	}
 	player, err := cardinal.GetComponent[Player](world, playerID)
 	if err != nil {
 		return fmt.Errorf("error getting player: %w", err)
 	}
 
-	// Check if Items is nil and initialize if necessary
-	if player.Items == nil {
-		player.Items = make([]types.EntityID, 0)
-	}
-
-	// Check if the item exists
-	var itemFound bool
-	for i, existingItemID := range player.Items {
-		if existingItemID == itemID {
-			// Remove the item by slicing the array
-			player.Items = append(player.Items[:i], player.Items[i+1:]...)
-			itemFound = true
-			break
-		}
-	}
-
-	if !itemFound {
-		return fmt.Errorf("item %d does not belong to the player", itemID)
+	player.MigrateInventory()
+	if err := player.removeStack(itemID, quantity); err != nil {
+		return err
 	}
 
 	// Save the updated player state
@@ -251,34 +420,10 @@ func RemoveItem(world cardinal.WorldContext, playerID types.EntityID, itemID typ
 // FindPlayerByPersonaTag finds a Player with the given PersonaTag and returns their EntityID.
 //
 // Code Flow:
-// 1. Create a search query for Players.
-// 2. Iterate over the search results and check if the PersonaTag matches the specified PersonaTag.
-// 3. Return the EntityID of the Player if a match is found, or an error if not.
-
-// TODO: refactor this, dont like how the find overlaps with return 0 EntityID works if not found.
+// 1. Look the PersonaTag up in the Index (see `lookup`).
+// 2. Return the EntityID of the Player if a match is found, or an error if not.
 func FindPlayerByPersonaTag(world cardinal.WorldContext, personaTag string) (types.EntityID, error) {
-	q := cardinal.NewSearch().Entity(
-		filter.Contains(filter.Component[Player]()),
-	)
-
-	var playerId types.EntityID
-	var found bool = false
-
-	q.Each(world, func(id types.EntityID) bool {
-		player, err := cardinal.GetComponent[Player](world, id)
-		if err != nil {
-			return true
-		}
-
-		if player.PersonaTag == personaTag {
-			playerId = id
-			found = true
-			return false // Stop searching once we find the player
-		}
-
-		return true
-	})
-
+	playerId, found := lookupPlayer(world, personaTag)
 	if !found {
 		return 0, fmt.Errorf("player not found")
 	}
@@ -289,31 +434,16 @@ func FindPlayerByPersonaTag(world cardinal.WorldContext, personaTag string) (typ
 // GetPlayerByPersonaTag gets a Player with the given PersonaTag.
 //
 // Code Flow:
-// 1. Create a search query for Players.
-// 2. Iterate over the search results and check if the PersonaTag matches the specified PersonaTag.
-// 3. Return the Player if a match is found, or an error if not.
+// 1. Find the Player's EntityID (see `FindPlayerByPersonaTag`).
+// 2. Return the Player if a match is found, or an error if not.
 func GetPlayerByPersonaTag(world cardinal.WorldContext, personaTag string) (*Player, error) {
-	q := cardinal.NewSearch().Entity(
-		filter.Contains(filter.Component[Player]()),
-	)
-
-	var player *Player
-	var err error
-
-	q.Each(world, func(id types.EntityID) bool {
-		player, err = cardinal.GetComponent[Player](world, id)
-		if err != nil {
-			return true
-		}
-
-		if player.PersonaTag == personaTag {
-			return false // Stop searching once we find the player
-		}
-
-		return true
-	})
+	playerId, err := FindPlayerByPersonaTag(world, personaTag)
+	if err != nil {
+		return nil, err
+	}
 
-	if player == nil {
+	player, err := cardinal.GetComponent[Player](world, playerId)
+	if err != nil {
 		return nil, fmt.Errorf("player not found")
 	}
 
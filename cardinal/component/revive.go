@@ -0,0 +1,25 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+/**
+ * Revive represents a component attached to rare care items that can bring a deceased pet back.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   Items created from the item catalog carry a `game.EffectRevive` effect instead, the component is
+ *   read by GetItemEffects for items created before the catalog.
+ */
+type Revive struct {
+	// Value is the health, energy, hygiene, wellness and satiety of the pet after revival.
+	Value int `json:"value"`
+}
+
+/**
+ * Name returns the name of the Revive component.
+ *
+ * Returns:
+ *   (string): The name of the Revive component.
+ */
+func (Revive) Name() string {
+	return "Revive"
+}
@@ -0,0 +1,214 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+	"slices"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+// Immunity protects a pet against a class of diseases until it expires.
+type Immunity struct {
+	// Class is the class of diseases, see the `game.Disease*` classes.
+	Class string `json:"class"`
+	// ExpiresTick is the tick at which the pet can catch the diseases of the class again.
+	ExpiresTick uint64 `json:"expires_tick"`
+}
+
+/**
+ * Sickness represents the disease a pet suffers from, if any, and its immunities.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   It is added to a pet the first time it falls sick or takes a drug (see `TreatPet`),
+ *   and the disease is made worse over time by the sickness system.
+ */
+type Sickness struct {
+	// Disease is the name of the disease, see `game.Diseases`. Empty for a healthy pet.
+	Disease string `json:"disease"`
+	// Severity goes from 1 when the pet falls sick to `game.MaxSeverity`.
+	Severity int `json:"severity"`
+	// Since is the tick the pet fell sick.
+	Since uint64 `json:"since"`
+	// Immunities are the classes of diseases the pet cannot catch.
+	Immunities []Immunity `json:"immunities"`
+}
+
+/**
+ * Name returns the name of the Sickness component.
+ *
+ * Returns:
+ *   (string): The name of the Sickness component.
+ */
+func (Sickness) Name() string {
+	return "Sickness"
+}
+
+/**
+ * Properties returns the game properties (symptoms, cures) of the pet's disease, and false for a healthy pet.
+ */
+func (s Sickness) Properties() (game.DiseaseProperties, bool) {
+	if s.Disease == "" {
+		return game.DiseaseProperties{}, false
+	}
+	return game.DiseaseByName(s.Disease)
+}
+
+/**
+ * IsImmune reports whether the pet cannot catch the diseases of a class at the given tick.
+ */
+func (s Sickness) IsImmune(class string, tick uint64) bool {
+	for _, immunity := range s.Immunities {
+		if immunity.Class == class && tick < immunity.ExpiresTick {
+			return true
+		}
+	}
+	return false
+}
+
+/**
+ * DeclinePercent returns the percentage of the usual decline of a stat caused by the pet's disease, 100 for a healthy pet.
+ */
+func (s Sickness) DeclinePercent(stat string) int {
+	disease, ok := s.Properties()
+	if !ok {
+		return 100
+	}
+	return disease.DeclinePercent(stat, s.Severity)
+}
+
+/**
+ * GetPetSickness returns the sickness of a pet, and false if the pet never fell sick nor took a drug.
+ */
+func GetPetSickness(world cardinal.WorldContext, petId types.EntityID) (*Sickness, bool) {
+	sickness, err := cardinal.GetComponent[Sickness](world, petId)
+	if err != nil {
+		return nil, false
+	}
+	return sickness, true
+}
+
+/**
+ * getOrAddPetSickness returns the sickness of a pet, adding the Sickness component to pets that never had one.
+ */
+func getOrAddPetSickness(world cardinal.WorldContext, petId types.EntityID) (*Sickness, error) {
+	if sickness, ok := GetPetSickness(world, petId); ok {
+		return sickness, nil
+	}
+	if err := cardinal.AddComponentTo[Sickness](world, petId); err != nil {
+		return nil, fmt.Errorf("failed to add [Sickness]: %w", err)
+	}
+	return &Sickness{Immunities: make([]Immunity, 0)}, nil
+}
+
+/**
+ * InfectPet makes a pet fall sick with a disease, at the lowest severity.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   disease (string): The name of the disease, see `game.Diseases`.
+ *
+ * Returns:
+ *   error: An error if the Sickness component could not be updated.
+ */
+func InfectPet(world cardinal.WorldContext, petId types.EntityID, disease string) error {
+	sickness, err := getOrAddPetSickness(world, petId)
+	if err != nil {
+		return err
+	}
+	sickness.Disease = disease
+	sickness.Severity = 1
+	sickness.Since = world.CurrentTick()
+	if err := cardinal.SetComponent(world, petId, sickness); err != nil {
+		return fmt.Errorf("failed to infect pet [set Sickness]: %w", err)
+	}
+	return nil
+}
+
+/**
+ * HealPet rids a pet of its disease, if any, keeping its immunities.
+ */
+func HealPet(world cardinal.WorldContext, petId types.EntityID) error {
+	sickness, ok := GetPetSickness(world, petId)
+	if !ok || sickness.Disease == "" {
+		return nil
+	}
+	sickness.heal()
+	if err := cardinal.SetComponent(world, petId, sickness); err != nil {
+		return fmt.Errorf("failed to heal pet [set Sickness]: %w", err)
+	}
+	return nil
+}
+
+// heal clears the disease.
+func (s *Sickness) heal() {
+	s.Disease = ""
+	s.Severity = 0
+	s.Since = 0
+}
+
+// Treatment is what a drug did against diseases.
+type Treatment struct {
+	// Cured is the disease the drug cured, empty if it cured none.
+	Cured string `json:"cured"`
+	// Prevents are the classes of diseases the pet is now immune to.
+	Prevents []string `json:"prevents"`
+}
+
+/**
+ * TreatPet gives a drug to a pet, curing and preventing the diseases stored with its effects (see `ItemEffects`).
+ *
+ * Code Flow:
+ * 1. Return if the item is not a drug.
+ * 2. Cure the pet's disease if the drug cures it.
+ * 3. Make the pet immune to the classes of diseases the drug prevents for `game.ImmunityTicks`,
+ *    refreshing the expiry of the immunities it already has.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   itemID (types.EntityID): The ID of the item given to the pet.
+ *
+ * Returns:
+ *   (Treatment, error): What the drug did, and an error if the Sickness component could not be updated.
+ */
+func TreatPet(world cardinal.WorldContext, petId types.EntityID, itemID types.EntityID) (Treatment, error) {
+	// Step 1: Only drugs treat diseases
+	drug, err := cardinal.GetComponent[ItemEffects](world, itemID)
+	if err != nil || !drug.IsDrug() {
+		return Treatment{}, nil
+	}
+	sickness, err := getOrAddPetSickness(world, petId)
+	if err != nil {
+		return Treatment{}, err
+	}
+
+	// Step 2: Cure the disease
+	var treatment Treatment
+	if sickness.Disease != "" && slices.Contains(drug.Cures, sickness.Disease) {
+		treatment.Cured = sickness.Disease
+		sickness.heal()
+	}
+
+	// Step 3: Start the immunities
+	expires := world.CurrentTick() + game.ImmunityTicks
+	for _, class := range drug.Prevents {
+		i := slices.IndexFunc(sickness.Immunities, func(immunity Immunity) bool { return immunity.Class == class })
+		if i >= 0 {
+			sickness.Immunities[i].ExpiresTick = expires
+		} else {
+			sickness.Immunities = append(sickness.Immunities, Immunity{Class: class, ExpiresTick: expires})
+		}
+		treatment.Prevents = append(treatment.Prevents, class)
+	}
+
+	if err := cardinal.SetComponent(world, petId, sickness); err != nil {
+		return Treatment{}, fmt.Errorf("failed to treat pet [set Sickness]: %w", err)
+	}
+	return treatment, nil
+}
@@ -1,23 +1,89 @@
 // Package component contains various components for the Tamagotchi game.
 package component
 
-// Skill represents a skill that a player or pet can have.
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * Skill represents a skill that a player or pet can have.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   However, it is used in conjunction with other components and functions to manage skills in the game.
+ */
 type Skill struct {
-	// Kind is the type of skill, such as "fighting" or "magic".
+	/**
+	 * Kind is the type of skill, such as "fighting" or "magic".
+	 */
 	Kind string
-	// Level is the current level of the skill.
+	/**
+	 * Level is the current level of the skill.
+	 */
 	Level int64 `json:"lvl"`
-	// XP is the amount of experience points the skill has.
+	/**
+	 * XP is the amount of experience points the skill has.
+	 */
 	XP int64 `json:"exp"`
-	// NextLevelXP is the amount of experience points needed to reach the next level.
+	/**
+	 * NextLevelXP is the amount of experience points needed to reach the next level.
+	 */
 	NextLevelXP int64
 }
 
-// Name returns the name of the component.
-//
-// Code Flow:
-// 1. The function simply returns the string "Skill" as the name of the component.
+/**
+ * Name returns the name of the Skill component.
+ *
+ * Returns:
+ *   (string): The name of the Skill component.
+ */
 func (Skill) Name() string {
-	// The function returns the string "Skill" as the name of the component.
 	return "Skill"
 }
+
+/**
+ * NewSkill returns a level 0 Skill of the given kind, on the XP curve of `CalculateNextLevelXP`.
+ */
+func NewSkill(kind string) Skill {
+	return Skill{Kind: kind, NextLevelXP: CalculateNextLevelXP(0, baseXP, growthRate)}
+}
+
+/**
+ * AddXP adds experience points to the skill, levelling it up on the same curve as pets (see `gainXP`).
+ */
+func (s *Skill) AddXP(xp int64) {
+	gainXP(&s.Level, &s.XP, &s.NextLevelXP, xp)
+}
+
+/**
+ * GetPetSkill retrieves the pet's Skill component.
+ *
+ * Code Flow:
+ * 1. Fetch the pet's Skill component.
+ * 2. Pets created before every pet had a skill get a random one on first use.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (*Skill, error): The pet's Skill component, and any error that occurs during the process.
+ */
+func GetPetSkill(world cardinal.WorldContext, petId types.EntityID) (*Skill, error) {
+	if skill, err := cardinal.GetComponent[Skill](world, petId); err == nil {
+		return skill, nil
+	}
+	if err := cardinal.AddComponentTo[Skill](world, petId); err != nil {
+		return nil, fmt.Errorf("failed to get skill [add Skill]: %w", err)
+	}
+	skill := NewSkill(game.Skills[world.Rand().Intn(len(game.Skills))])
+	if err := cardinal.SetComponent(world, petId, &skill); err != nil {
+		return nil, fmt.Errorf("failed to get skill [set Skill]: %w", err)
+	}
+	return &skill, nil
+}
@@ -0,0 +1,52 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+	"slices"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+/**
+ * FindStoreForItem returns the name of the store (FoodStore, DrugStore or ToyStore) that stocks the item.
+ *
+ * Code Flow:
+ * 1. Search the food, drug and toy stores in turn.
+ * 2. Return the name of the first store whose list contains the item, or an error if none does.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   itemID (types.EntityID): The ID of the item.
+ *
+ * Returns:
+ *   (string, error): The name of the store component, and an error if no store stocks the item.
+ */
+func FindStoreForItem(world cardinal.WorldContext, itemID types.EntityID) (string, error) {
+	if storeStocks(world, itemID, func(s *FoodStore) []types.EntityID { return s.Foods }) {
+		return FoodStore{}.Name(), nil
+	}
+	if storeStocks(world, itemID, func(s *DrugStore) []types.EntityID { return s.Drugs }) {
+		return DrugStore{}.Name(), nil
+	}
+	if storeStocks(world, itemID, func(s *ToyStore) []types.EntityID { return s.Toys }) {
+		return ToyStore{}.Name(), nil
+	}
+	return "", fmt.Errorf("no store trades item %d", itemID)
+}
+
+// storeStocks reports whether any store of type T lists the item.
+func storeStocks[T types.Component](world cardinal.WorldContext, itemID types.EntityID, items func(*T) []types.EntityID) bool {
+	found := false
+	_ = cardinal.NewSearch().Entity(filter.Contains(filter.Component[T]())).Each(world, func(id types.EntityID) bool {
+		store, err := cardinal.GetComponent[T](world, id)
+		if err != nil {
+			return true
+		}
+		found = slices.Contains(items(store), itemID)
+		return !found
+	})
+	return found
+}
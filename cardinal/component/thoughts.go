@@ -0,0 +1,170 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+// ThoughtEntry is a thought a pet had.
+type ThoughtEntry struct {
+	// Tick is the tick the pet had the thought.
+	Tick uint64 `json:"tick"`
+	// Need is what the thought is about, a `game.Stat*` or `game.Thought*` constant.
+	Need string `json:"need"`
+	// Text is the thought itself.
+	Text string `json:"text"`
+}
+
+/**
+ * Thoughts represents what a pet has been thinking about, and what just happened to it.
+ *
+ * Code Flow:
+ *   This struct has no specific code flow as it is a simple data structure.
+ *   New thoughts are recorded by `RecordPetThought`, keeping the last `game.MaxThoughtHistory`,
+ *   and events by `NotePetEvent`.
+ */
+type Thoughts struct {
+	// History holds the last thoughts of the pet, oldest first.
+	History []ThoughtEntry `json:"history"`
+	// Event is the last thing that happened to the pet (see `game.ActivityEvent`).
+	Event string `json:"event"`
+	// EventTick is the tick the event happened.
+	EventTick uint64 `json:"event_tick"`
+	// BonusTick is the first tick asking the pet what it thinks gives it a wellness bonus again.
+	BonusTick uint64 `json:"bonus_tick"`
+}
+
+/**
+ * Name returns the name of the Thoughts component.
+ *
+ * Returns:
+ *   (string): The name of the Thoughts component.
+ */
+func (Thoughts) Name() string {
+	return "Thoughts"
+}
+
+/**
+ * RecentEvent returns the event the pet is still thinking about at tick, empty when it happened
+ * more than `game.RecentEventTicks` ago.
+ */
+func (t Thoughts) RecentEvent(tick uint64) string {
+	if t.Event == "" || tick > t.EventTick+game.RecentEventTicks {
+		return ""
+	}
+	return t.Event
+}
+
+/**
+ * GetPetThoughts returns the thoughts of a pet, and false for pets created before thoughts were remembered.
+ */
+func GetPetThoughts(world cardinal.WorldContext, petId types.EntityID) (*Thoughts, bool) {
+	thoughts, err := cardinal.GetComponent[Thoughts](world, petId)
+	if err != nil {
+		return nil, false
+	}
+	return thoughts, true
+}
+
+/**
+ * getOrAddPetThoughts returns the thoughts of a pet, adding the Thoughts component to pets created before
+ * thoughts were remembered.
+ */
+func getOrAddPetThoughts(world cardinal.WorldContext, petId types.EntityID) (*Thoughts, error) {
+	if thoughts, ok := GetPetThoughts(world, petId); ok {
+		return thoughts, nil
+	}
+	if err := cardinal.AddComponentTo[Thoughts](world, petId); err != nil {
+		return nil, fmt.Errorf("failed to get thoughts [add Thoughts]: %w", err)
+	}
+	return &Thoughts{}, nil
+}
+
+/**
+ * RecordPetThought makes a pet think a thought.
+ *
+ * Code Flow:
+ * 1. Set the Think component of the pet to the thought.
+ * 2. Get the Thoughts component, adding it to pets created before thoughts were remembered.
+ * 3. Append the thought to the history unless the pet is already thinking it, keeping the last `game.MaxThoughtHistory`.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   need (string): What the thought is about, a `game.Stat*` or `game.Thought*` constant.
+ *   text (string): The thought.
+ *
+ * Returns:
+ *   error: An error if the pet could not be updated.
+ */
+func RecordPetThought(world cardinal.WorldContext, petId types.EntityID, need string, text string) error {
+	// Step 1: Set the Think component
+	if err := cardinal.SetComponent(world, petId, &Think{Think: text}); err != nil {
+		return fmt.Errorf("failed to record thought [set Think]: %w", err)
+	}
+
+	// Step 2: Get the Thoughts component
+	thoughts, err := getOrAddPetThoughts(world, petId)
+	if err != nil {
+		return err
+	}
+
+	// Step 3: Append the thought to the history
+	if n := len(thoughts.History); n > 0 && thoughts.History[n-1].Text == text {
+		return nil
+	}
+	thoughts.History = append(thoughts.History, ThoughtEntry{Tick: world.CurrentTick(), Need: need, Text: text})
+	if len(thoughts.History) > game.MaxThoughtHistory {
+		thoughts.History = thoughts.History[len(thoughts.History)-game.MaxThoughtHistory:]
+	}
+	if err := cardinal.SetComponent(world, petId, thoughts); err != nil {
+		return fmt.Errorf("failed to record thought [set Thoughts]: %w", err)
+	}
+	return nil
+}
+
+/**
+ * NotePetEvent remembers that something just happened to a pet, so it thinks about it for a while.
+ * Pets created before thoughts were remembered get their Thoughts component on their next thought,
+ * which is why events happening before that are not remembered.
+ */
+func NotePetEvent(world cardinal.WorldContext, petId types.EntityID, event string) error {
+	thoughts, ok := GetPetThoughts(world, petId)
+	if !ok {
+		return nil
+	}
+	thoughts.Event = event
+	thoughts.EventTick = world.CurrentTick()
+	if err := cardinal.SetComponent(world, petId, thoughts); err != nil {
+		return fmt.Errorf("failed to note event [set Thoughts]: %w", err)
+	}
+	return nil
+}
+
+/**
+ * TakeAskBonus reports whether asking a pet what it thinks earns it a wellness bonus now,
+ * in which case the next bonus is only given `cooldown` ticks later.
+ *
+ * Returns:
+ *   (bool, uint64, error): Whether the bonus is given, the first tick of the next bonus,
+ *   and an error if the Thoughts component could not be updated.
+ */
+func TakeAskBonus(world cardinal.WorldContext, petId types.EntityID, cooldown uint64) (bool, uint64, error) {
+	thoughts, err := getOrAddPetThoughts(world, petId)
+	if err != nil {
+		return false, 0, err
+	}
+	if world.CurrentTick() < thoughts.BonusTick {
+		return false, thoughts.BonusTick, nil
+	}
+	thoughts.BonusTick = world.CurrentTick() + cooldown
+	if err := cardinal.SetComponent(world, petId, thoughts); err != nil {
+		return false, 0, fmt.Errorf("failed to take ask bonus [set Thoughts]: %w", err)
+	}
+	return true, thoughts.BonusTick, nil
+}
@@ -29,12 +29,12 @@ func (ToyStore) Name() string {
 }
 
 /**
- * InitToyStore initializes the toy store by creating toy entities based on the game's toy kinds.
+ * InitToyStore initializes the toy store by creating toy entities based on the toys of the item catalog.
  *
  * Code Flow:
- * 1. Fetch the logger from the world context.
- * 2. Iterate over the game's toy kinds and create an item for each kind.
- * 3. Create an entity for each item with the item and wellness components.
+ * 1. Fetch the logger from the world context and load the item catalog (see `game.Catalog`).
+ * 2. Iterate over the toys of the catalog.
+ * 3. Create an entity for each toy with the item and its effects.
  * 4. Append the entity ID to the toy store's list of toys.
  * 5. Handle any errors that occur during the creation process.
  *
@@ -42,28 +42,23 @@ func (ToyStore) Name() string {
  *   world (cardinal.WorldContext): The world context.
  */
 func (shop *ToyStore) InitToyStore(world cardinal.WorldContext) {
-	// Step 1: Fetch the logger from the world context.
+	// Step 1: Fetch the logger from the world context and load the item catalog.
 	log := world.Logger()
+	catalog, err := game.Catalog()
+	if err != nil {
+		log.Error().Msgf("Failed to load item catalog: %v", err)
+		return
+	}
 
-	// Step 2: Iterate over the game's toy kinds and create an item for each kind.
-	for toyName, properties := range game.ToyKinds {
-		// Create an item with the properties from the map
-		item := Item{
-			ItemName:    toyName,
-			Kind:        ItemToy.String(),
-			Description: properties.Description,
-			Price:       properties.Price,
-		}
-
-		// Step 3: Create the entity with the item and its components
-		entityId, err := cardinal.Create(world, item,
-			Wellness{Wn: properties.Wellness},
-		)
+	// Step 2: Iterate over the toys of the catalog.
+	for _, toy := range catalog.Toys {
+		// Step 3: Create the entity with the item and its effects
+		entityId, err := CreateCatalogItem(world, ItemToy, toy)
 
 		// Step 5: Handle any errors that occur during the creation process.
 		if err != nil {
 			// Log the error and return.
-			log.Error().Msgf("Failed to create toy %s: %v", toyName, err)
+			log.Error().Msgf("Failed to create toy %s: %v", toy.Name, err)
 			return
 		}
 
@@ -0,0 +1,98 @@
+// Package component contains structures and functions for working with game components.
+package component
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+/**
+ * Trade is an offer from one persona to another. Each trade is its own entity, and its entity ID is the trade ID.
+ *
+ * Code Flow:
+ *   Proposing a trade takes the offered assets from the proposer into escrow.
+ *   Accepting it takes the requested assets from the recipient and swaps both bundles.
+ *   Cancelling it, or letting it expire, returns the offered assets to the proposer.
+ */
+type Trade struct {
+	// Proposer is the persona tag of the player making the offer.
+	Proposer string `json:"proposer"`
+	// Recipient is the persona tag of the player the offer is made to.
+	Recipient string `json:"recipient"`
+	// Offer holds the proposer's assets in escrow.
+	Offer Escrow `json:"offer"`
+	// Request is what the recipient gives in exchange, taken when the trade is accepted.
+	Request Escrow `json:"request"`
+	// CreatedTick is the tick the trade was proposed.
+	CreatedTick uint64 `json:"created_tick"`
+	// ExpiresTick is the tick the offer is returned to the proposer if it was not accepted.
+	ExpiresTick uint64 `json:"expires_tick"`
+}
+
+/**
+ * Name returns the name of the Trade component.
+ *
+ * Returns:
+ *   (string): The name of the Trade component.
+ */
+func (Trade) Name() string {
+	return "Trade"
+}
+
+/**
+ * Involves reports whether the persona is the proposer or the recipient of the trade.
+ */
+func (t Trade) Involves(personaTag string) bool {
+	return t.Proposer == personaTag || t.Recipient == personaTag
+}
+
+/**
+ * GetTrade retrieves a pending trade by its ID.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   tradeID (types.EntityID): The ID of the trade.
+ *
+ * Returns:
+ *   (*Trade, error): The Trade component, and an error if there is no pending trade with that ID.
+ */
+func GetTrade(world cardinal.WorldContext, tradeID types.EntityID) (*Trade, error) {
+	trade, err := cardinal.GetComponent[Trade](world, tradeID)
+	if err != nil {
+		return nil, fmt.Errorf("trade %d not found", tradeID)
+	}
+	return trade, nil
+}
+
+/**
+ * CloseTrade returns the offered assets to the proposer and removes the trade.
+ *
+ * Code Flow:
+ * 1. Find the proposer's player entity.
+ * 2. Release the escrowed offer back to the proposer, recorded as a refund.
+ * 3. Remove the trade entity.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   tradeID (types.EntityID): The ID of the trade.
+ *   trade (*Trade): The Trade component.
+ *   reason (string): The ledger reason of the money returned.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func CloseTrade(world cardinal.WorldContext, tradeID types.EntityID, trade *Trade, reason string) error {
+	proposerID, err := FindPlayerByPersonaTag(world, trade.Proposer)
+	if err != nil {
+		return err
+	}
+	if err := ReleaseEscrow(world, proposerID, trade.Offer, reason, fmt.Sprintf("trade %d", tradeID)); err != nil {
+		return err
+	}
+	if err := cardinal.Remove(world, tradeID); err != nil {
+		return fmt.Errorf("error closing trade [remove Trade]: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package game
+
+// Activities a pet can be engaged in
+const (
+	ActivitySleeping   = "Sleeping"
+	ActivityEating     = "Eating"
+	ActivityBathing    = "Bathing"
+	ActivityPlaying    = "Playing"
+	ActivityTraining   = "Training"
+	ActivityPracticing = "Practicing"
+	ActivityBattling   = "Battling"
+	ActivityVacation   = "Vacation"
+	ActivityDaycare    = "Daycare"
+	ActivityEscrow     = "Escrow"
+)
+
+// Sleeping restores EnergyIncrease over the night, one point every SleepEnergyTickRate ticks
+const SleepEnergyTickRate = TickEightHours / EnergyIncrease
+
+// WakeUpPenalty is the wellness lost by a pet woken up before the end of its sleep
+const WakeUpPenalty = 10
+
+// ActivityKind declares how a timed activity plays out. The effects of the item starting an activity,
+// if any, are applied when the activity starts, the effects below while it runs and when it ends.
+type ActivityKind struct {
+	Name     string
+	Duration int    // Ticks, 0 when the duration is set by whatever starts the activity
+	Think    string // What the pet thinks during the activity, empty to keep its thought
+
+	// TickEffects are applied every TickRate ticks while the activity runs.
+	// During the day they are applied DayPercent as often, 0 meaning the time of day does not matter.
+	TickRate    int
+	TickEffects []ItemEffect
+	DayPercent  int
+
+	// CompletionEffects are applied when the activity runs to its end.
+	CompletionEffects []ItemEffect
+
+	// Cancelable activities can be stopped early, in which case CancelEffects are applied.
+	Cancelable    bool
+	CancelEffects []ItemEffect
+
+	// Parked activities look after a pet while its owner is away: its stats decline DeclinePercent of the
+	// usual rate, and instead of earning from the pet the owner pays HourlyCost at the start of every hour.
+	Parked         bool
+	DeclinePercent int
+	HourlyCost     Money
+
+	// Held activities last until whatever started them stops them, so they do not count down,
+	// e.g. while a pet is held in escrow by a trade or a listing.
+	Held bool
+}
+
+// ActivityKinds holds every timed activity, by name.
+var ActivityKinds = map[string]ActivityKind{
+	ActivitySleeping: {
+		Name: ActivitySleeping, Duration: TickEightHours, Think: ThinkSleep,
+		TickRate: SleepEnergyTickRate, TickEffects: []ItemEffect{{Stat: StatEnergy, Amount: 1}}, DayPercent: DaySleepPercent,
+		CompletionEffects: []ItemEffect{{Stat: StatWellness, Amount: WellnessIncrease}},
+		Cancelable:        true, CancelEffects: []ItemEffect{{Stat: StatWellness, Amount: -WakeUpPenalty}},
+	},
+	ActivityEating: {
+		Name: ActivityEating, Duration: TickHour, Think: ThinkEat,
+		CompletionEffects: []ItemEffect{{Stat: StatWellness, Amount: WellnessIncrease}},
+		Cancelable:        true,
+	},
+	ActivityBathing: {
+		Name: ActivityBathing, Duration: TickHour,
+		CompletionEffects: []ItemEffect{{Stat: StatWellness, Amount: WellnessIncrease}},
+		Cancelable:        true,
+	},
+	ActivityPlaying: {
+		Name: ActivityPlaying, Duration: TickHour,
+		Cancelable: true,
+	},
+	ActivityTraining: {
+		Name: ActivityTraining, Duration: AbilityTrainingTicks, Think: ThinkTrain,
+		Cancelable: true,
+	},
+	ActivityPracticing: {
+		Name: ActivityPracticing, Duration: AbilityTrainingTicks, Think: ThinkPractice,
+		Cancelable: true,
+	},
+	ActivityBattling: {
+		Name: ActivityBattling, Duration: BattleMaxRounds*BattleTickRate + 1, // Outlasts the longest battle
+	},
+	ActivityVacation: {
+		Name: ActivityVacation, Think: ThinkVacation,
+		Cancelable: true,
+		Parked:     true, DeclinePercent: VacationDeclinePercent, HourlyCost: VacationHourlyCost,
+	},
+	ActivityDaycare: {
+		Name: ActivityDaycare, Think: ThinkDaycare,
+		Cancelable: true,
+		Parked:     true, DeclinePercent: DaycareDeclinePercent, HourlyCost: DaycareHourlyCost,
+	},
+	ActivityEscrow: {
+		Name: ActivityEscrow, Duration: ListingExpiryTicks, // At most until the listing expires
+		Parked: true, DeclinePercent: 0, HourlyCost: 0,
+		Held: true,
+	},
+}
+
+// TickRateAt returns the number of ticks between two applications of the tick effects at the given time of day.
+func (k ActivityKind) TickRateAt(clock Clock) int {
+	if k.DayPercent <= 0 || clock.IsNight() {
+		return k.TickRate
+	}
+	return k.TickRate * 100 / k.DayPercent
+}
+
+// ActivityByName returns the kind of an activity, and false for unknown activities.
+func ActivityByName(name string) (ActivityKind, bool) {
+	kind, ok := ActivityKinds[name]
+	return kind, ok
+}
+
+// ProrateEffects scales the amount of each effect to the share of the activity that ran, `elapsed` of `total` ticks.
+func ProrateEffects(effects []ItemEffect, elapsed int, total int) []ItemEffect {
+	if total <= 0 {
+		return nil
+	}
+	elapsed = min(max(elapsed, 0), total)
+	prorated := make([]ItemEffect, 0, len(effects))
+	for _, effect := range effects {
+		effect.Amount = effect.Amount * elapsed / total
+		prorated = append(prorated, effect)
+	}
+	return prorated
+}
+
+// Actions that can be queued on a pet, started one after the other as its activities end
+const (
+	QueueSleep = "sleep"
+	QueueFeed  = "feed"
+	QueueBath  = "bath"
+	QueuePlay  = "play"
+)
+
+// MaxActivityQueue is the maximum number of actions waiting in a pet's queue
+const MaxActivityQueue = 5
+
+// Vacation modes, parking a pet while its owner is away until it returns after the chosen number of hours
+const (
+	VacationModeVacation = "vacation" // The pet's stats are frozen
+	VacationModeDaycare  = "daycare"  // The pet's stats decline slowly, for a lower cost
+)
+
+// VacationActivities maps each vacation mode to the activity parking the pet
+var VacationActivities = map[string]string{
+	VacationModeVacation: ActivityVacation,
+	VacationModeDaycare:  ActivityDaycare,
+}
+
+// Vacation mode
+const (
+	VacationDeclinePercent       = 0         // Stats are frozen while the pet is on vacation
+	DaycareDeclinePercent        = 25        // Stats decline at a quarter of the usual rate at the daycare
+	VacationHourlyCost     Money = Coin / 2  // 0.5 coins every hour
+	DaycareHourlyCost      Money = Coin / 10 // 0.1 coins every hour
+	MaxVacationHours             = 24 * 7    // A pet can be parked for a week at most
+)
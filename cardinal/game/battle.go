@@ -0,0 +1,47 @@
+package game
+
+// BattleStats are the attack, defense and speed of a pet in battle.
+type BattleStats struct {
+	Attack  int
+	Defense int
+	Speed   int
+}
+
+// StatsForSkill returns the battle stats of a skill.
+func StatsForSkill(skill string) BattleStats {
+	if stats, ok := SkillStats[skill]; ok {
+		return stats
+	}
+	return DefaultSkillStats
+}
+
+// Combatant is what a pet brings to a battle round.
+type Combatant struct {
+	Element string
+	Stats   BattleStats
+	Level   int64
+	Energy  int
+}
+
+// ElementModifier returns the percentage of damage an attack of element `attacker` deals to element `defender`.
+func ElementModifier(attacker string, defender string) int {
+	switch {
+	case attacker != "" && ElementAdvantages[attacker] == defender:
+		return 100 + ElementAdvantageBonus
+	case defender != "" && ElementAdvantages[defender] == attacker:
+		return 100 - ElementDisadvantageCut
+	default:
+		return 100
+	}
+}
+
+// BattleDamage returns the damage of one attack. It only depends on its inputs, so battles replay identically.
+//
+// Level adds to the skill attack, the defender's skill defense soaks part of it, the element
+// modifier scales it, and a tired pet (low energy) hits down to half as hard. Every attack deals at least 1.
+func BattleDamage(attacker Combatant, defender Combatant) int {
+	damage := max(2*(attacker.Stats.Attack+int(attacker.Level))-defender.Stats.Defense, 1)
+	damage = damage * ElementModifier(attacker.Element, defender.Element) / 100
+	damage = damage * (50 + min(max(attacker.Energy, 0), MaxEnergy)/2) / 100
+	return max(damage, 1)
+}
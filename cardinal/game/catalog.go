@@ -0,0 +1,212 @@
+package game
+
+import (
+	"bytes"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sync"
+)
+
+// CatalogEnv names the environment variable pointing to an item catalog file that replaces the built-in one.
+const CatalogEnv = "TAMAGOTCHI_ITEM_CATALOG"
+
+// EffectRevive is the effect of items that bring a deceased pet back, its amount is the pet's stats after revival.
+const EffectRevive = "revive"
+
+// ItemEffectStats are the stats an item effect can change.
+var ItemEffectStats = []string{StatHealth, StatEnergy, StatHygiene, StatWellness, StatSatiety, EffectRevive}
+
+//go:embed catalog.json
+var defaultCatalog []byte
+
+// ItemEffect changes one stat of the pet using the item. Negative amounts are side effects.
+// An effect with a Duration is a buff: instead of applying at once, the amount is applied
+// every `BuffTickRate` ticks until Duration ticks have passed.
+type ItemEffect struct {
+	Stat     string `json:"stat"`
+	Amount   int    `json:"amount"`
+	Duration int    `json:"duration,omitempty"`
+}
+
+// IsBuff reports whether the effect applies over time.
+func (effect ItemEffect) IsBuff() bool {
+	return effect.Duration > 0
+}
+
+// CatalogItem is an item sold by the stores. Price is in minor units (see `Money`).
+// Drugs also cure diseases and prevent classes of diseases, on top of their effects (see `Diseases`).
+type CatalogItem struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Price       Money        `json:"price"`
+	Effects     []ItemEffect `json:"effects"`
+	Cures       []string     `json:"cures,omitempty"`    // Diseases the item cures
+	Prevents    []string     `json:"prevents,omitempty"` // Classes of diseases the pet is immune to for ImmunityTicks after taking the item
+}
+
+// Effect returns the total amount the item changes the given stat by at once, buffs are not counted.
+func (item CatalogItem) Effect(stat string) int {
+	total := 0
+	for _, effect := range item.Effects {
+		if effect.Stat == stat && !effect.IsBuff() {
+			total += effect.Amount
+		}
+	}
+	return total
+}
+
+// ItemCatalog holds the items of every store, Care items are sold by the drug store.
+// Version identifies the content of the catalog, so worlds can tell when it was edited.
+type ItemCatalog struct {
+	Food    []CatalogItem `json:"food"`
+	Care    []CatalogItem `json:"care"`
+	Toys    []CatalogItem `json:"toys"`
+	Version string        `json:"-"`
+}
+
+// Item returns the catalog item with the given name.
+func (c *ItemCatalog) Item(name string) (CatalogItem, bool) {
+	for _, items := range [][]CatalogItem{c.Food, c.Care, c.Toys} {
+		for _, item := range items {
+			if item.Name == name {
+				return item, true
+			}
+		}
+	}
+	return CatalogItem{}, false
+}
+
+// Cures returns the names of the items curing the disease, sorted.
+func (c *ItemCatalog) Cures(disease string) []string {
+	var names []string
+	for _, items := range [][]CatalogItem{c.Food, c.Care, c.Toys} {
+		for _, item := range items {
+			if slices.Contains(item.Cures, disease) {
+				names = append(names, item.Name)
+			}
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Stores selling the items of the catalog, as named by their queries
+const (
+	StoreFood = "foodstore"
+	StoreDrug = "drugstore"
+	StoreToy  = "toystore"
+)
+
+// BestItemFor returns the item raising the given stat the most at once, the cheapest one on ties,
+// with the store selling it, and false when no item raises the stat.
+func (c *ItemCatalog) BestItemFor(stat string) (CatalogItem, string, bool) {
+	var best CatalogItem
+	bestStore := ""
+	stores := []struct {
+		name  string
+		items []CatalogItem
+	}{{StoreFood, c.Food}, {StoreDrug, c.Care}, {StoreToy, c.Toys}}
+	for _, store := range stores {
+		for _, item := range store.items {
+			effect := item.Effect(stat)
+			if effect <= 0 {
+				continue
+			}
+			if bestStore == "" || effect > best.Effect(stat) || (effect == best.Effect(stat) && item.Price < best.Price) {
+				best, bestStore = item, store.name
+			}
+		}
+	}
+	return best, bestStore, bestStore != ""
+}
+
+var (
+	catalogOnce sync.Once
+	catalog     *ItemCatalog
+	catalogErr  error
+)
+
+// Catalog returns the item catalog, loading it the first time from the file named by `CatalogEnv`,
+// or from the built-in catalog.json when the variable is not set.
+func Catalog() (*ItemCatalog, error) {
+	catalogOnce.Do(func() {
+		data := defaultCatalog
+		if path := os.Getenv(CatalogEnv); path != "" {
+			if data, catalogErr = os.ReadFile(path); catalogErr != nil {
+				catalogErr = fmt.Errorf("failed to read item catalog: %w", catalogErr)
+				return
+			}
+		}
+		catalog, catalogErr = ParseCatalog(data)
+	})
+	return catalog, catalogErr
+}
+
+// ParseCatalog decodes and validates an item catalog. Unknown fields, duplicated or unnamed items,
+// negative prices, unknown effect stats, invalid buffs, and unknown diseases or disease classes are rejected.
+func ParseCatalog(data []byte) (*ItemCatalog, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var c ItemCatalog
+	if err := decoder.Decode(&c); err != nil {
+		return nil, fmt.Errorf("failed to decode item catalog: %w", err)
+	}
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to decode item catalog: unexpected data after the catalog")
+	}
+
+	names := make(map[string]bool)
+	for _, items := range [][]CatalogItem{c.Food, c.Care, c.Toys} {
+		for _, item := range items {
+			if item.Name == "" {
+				return nil, fmt.Errorf("invalid item catalog: item without a name")
+			}
+			if names[item.Name] {
+				return nil, fmt.Errorf("invalid item catalog: item %s is listed twice", item.Name)
+			}
+			names[item.Name] = true
+			if item.Price < 0 {
+				return nil, fmt.Errorf("invalid item catalog: item %s has a negative price %d", item.Name, item.Price)
+			}
+			for _, effect := range item.Effects {
+				if !isItemEffectStat(effect.Stat) {
+					return nil, fmt.Errorf("invalid item catalog: item %s has an unknown effect stat %q", item.Name, effect.Stat)
+				}
+				if effect.Duration < 0 || (effect.IsBuff() && effect.Stat == EffectRevive) {
+					return nil, fmt.Errorf("invalid item catalog: item %s has an invalid %s buff duration %d", item.Name, effect.Stat, effect.Duration)
+				}
+			}
+			for _, disease := range item.Cures {
+				if _, ok := DiseaseByName(disease); !ok {
+					return nil, fmt.Errorf("invalid item catalog: item %s cures an unknown disease %q", item.Name, disease)
+				}
+			}
+			for _, class := range item.Prevents {
+				if !slices.Contains(DiseaseClasses, class) {
+					return nil, fmt.Errorf("invalid item catalog: item %s prevents an unknown class of diseases %q", item.Name, class)
+				}
+			}
+		}
+	}
+	sum := sha256.Sum256(data)
+	c.Version = hex.EncodeToString(sum[:])
+	return &c, nil
+}
+
+// isItemEffectStat reports whether the stat is one of `ItemEffectStats`.
+func isItemEffectStat(stat string) bool {
+	for _, known := range ItemEffectStats {
+		if stat == known {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package game
+
+// World clock. The time of day is derived from the current tick, one game hour lasting TickHour ticks.
+// The world starts at ClockStartHour on day 1.
+const ClockStartHour = 8
+
+// Phases of the day
+const (
+	PhaseDay   = "day"
+	PhaseNight = "night"
+)
+
+// Night runs from NightStartHour until DayStartHour the next morning
+const (
+	DayStartHour   = 6
+	NightStartHour = 22
+)
+
+// Circadian behavior
+const NightEnergyDeclinePercent = 150 // Pets get sleepier at night, losing energy 50% faster
+const DaySleepPercent = 50            // Sleeping during the day restores energy half as fast
+
+// Clock is the time of day at a tick.
+type Clock struct {
+	Tick             uint64 `json:"tick"`
+	Day              int    `json:"day"`
+	Hour             int    `json:"hour"`
+	Minute           int    `json:"minute"`
+	Phase            string `json:"phase"`
+	TicksToNextPhase uint64 `json:"ticks_to_next_phase"`
+}
+
+// ClockAt returns the time of day at the given tick.
+func ClockAt(tick uint64) Clock {
+	// Ticks since midnight of day 1
+	elapsed := tick + ClockStartHour*TickHour
+	sinceMidnight := elapsed % TickDay
+
+	clock := Clock{
+		Tick:   tick,
+		Day:    int(elapsed/TickDay) + 1,
+		Hour:   int(sinceMidnight / TickHour),
+		Minute: int(sinceMidnight % TickHour / TickMinute),
+		Phase:  PhaseDay,
+	}
+
+	// The next phase starts at DayStartHour or NightStartHour, possibly on the next day
+	next := uint64(NightStartHour * TickHour)
+	if clock.Hour < DayStartHour || clock.Hour >= NightStartHour {
+		clock.Phase = PhaseNight
+		next = DayStartHour * TickHour
+	}
+	if next <= sinceMidnight {
+		next += TickDay
+	}
+	clock.TicksToNextPhase = next - sinceMidnight
+	return clock
+}
+
+// IsNight reports whether the clock is in the night phase.
+func (c Clock) IsNight() bool {
+	return c.Phase == PhaseNight
+}
+
+// DeclinePercent returns the percentage of the usual decline of a stat at this time of day.
+func (c Clock) DeclinePercent(stat string) int {
+	if stat == StatEnergy && c.IsNight() {
+		return NightEnergyDeclinePercent
+	}
+	return 100
+}
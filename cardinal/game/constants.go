@@ -14,6 +14,8 @@ const ActivityUpdateTickRate = TickRate
 
 // Times
 const TickEightHours = TickHour * 8 // Sleeping
+const TickDay = TickHour * 24
+const TickWeek = TickDay * 7
 
 // Create Pet
 const (
@@ -21,15 +23,27 @@ const (
 	MaxEnergy       = 100
 	MaxHygiene      = 100
 	MaxWellness     = 100
+	MaxSatiety      = 100
 	InitialActivity = "None"
 	InitialThink    = "..."
 	MaxLevel        = int64(10)
 )
 
-const PetCost = 5
+const PetCost = 5 * Coin
 
 // Decline system
 const HygieneThreshold = 70
+const StarvationThreshold = 20            // Pets lose health at or below this satiety
+const LifeStageTickRate = DeclineTickRate // Check life stages every decline cycle
+
+// Pet stats, used to look up per-stat rates
+const (
+	StatHealth   = "health"
+	StatEnergy   = "energy"
+	StatHygiene  = "hygiene"
+	StatWellness = "wellness"
+	StatSatiety  = "satiety"
+)
 
 // Pet Play method
 const ExperienceEarn = 20
@@ -46,14 +60,90 @@ const HealthIncrease = 20
 // Pet Bath method
 const HygieneIncrease = 20
 
+// Item buffs
+const BuffTickRate = DeclineTickRate // Buffs apply their amount every decline cycle
+
+// Pet Train Skill and Practice Magic methods
+const AbilityTrainingTicks = TickHour
+const SkillTrainEnergy = 20
+const SkillTrainXP = 25
+const MagicPracticeEnergy = 25
+const MagicPracticeXP = 25
+const MaxAbilityLevel = MaxLevel
+
 // Pet Think
 const ThinkSleep = "Zzz...Zzz"
 const ThinkBath = "(Singing...)"
 const ThinkEat = "Mmm Yummy!"
 const ThinkPlay = "Love to play!"
+const ThinkTrain = "Feel the burn!"
+const ThinkPractice = "Abracadabra!"
+const ThinkVacation = "Wish you were here!"
+const ThinkDaycare = "When is my owner coming back?"
+
+// Pet Think method, asking the pet what it thinks
+const ThinkPetWellness = 2            // Wellness given by the attention of the owner
+const ThinkPetCooldown = TickHour / 2 // Asking again within half an hour gives no more wellness
 
 // Pet Activity
-const PetEarnMoney = 0.0001
+const PetEarnMoney Money = 1 // 0.0001 coins every activity tick
 
 // Player
-const PlayerInitialMoney = float64(1000)
+const PlayerInitialMoney = 1000 * Coin
+
+// Breed genetics
+const DnaMaxGene = 100       // Genes are kept in the [0, DnaMaxGene) range
+const DnaMutationChance = 5  // Percentage chance for each gene to mutate
+const DnaMutationRange = 10  // Maximum drift (+/-) applied to a mutated gene
+const KindInheritChance = 80 // Percentage chance a child keeps one of its parents' Magic/Skill kinds
+
+// Ledger reasons
+const (
+	LedgerPurchase       = "purchase"
+	LedgerPetCreation    = "pet_creation"
+	LedgerActivityIncome = "activity_income"
+	LedgerRefund         = "refund"
+	LedgerSale           = "sale"
+	LedgerTrade          = "trade"
+	LedgerVacation       = "vacation"
+)
+
+// Stores buy items back for this percentage of their price
+const SellBackPercent = 50
+
+// MaxStackQuantity is the most units of an item a player can buy in one order and hold in one stack
+const MaxStackQuantity = 999
+
+// Trading
+const TradeExpiryTicks = TickDay // Offers not accepted within a day are returned to the proposer
+
+// Marketplace
+const MarketFeePercent = 5          // The market keeps this percentage of every sale
+const ListingExpiryTicks = TickWeek // Listings not bought within a week are returned to the seller
+const MarketPageSize = 20
+const MarketMaxPageSize = 100
+
+// Battle
+const (
+	BattleTickRate         = TickRate // One round every tick
+	BattleMaxRounds        = 20       // The pet with the most health left wins once the rounds run out
+	BattleEnergyCost       = 5        // Energy spent by a pet on every attack
+	BattleWinXP            = 50
+	BattleLoseXP           = 10
+	ChallengeExpiryTicks   = TickHour // Challenges not accepted within an hour are dropped
+	BattleRetentionTicks   = TickDay  // Finished battles and their log are removed after a day
+	ElementAdvantageBonus  = 50       // Percentage of extra damage against a weaker element
+	ElementDisadvantageCut = 25       // Percentage of damage lost against a stronger element
+)
+
+// Ledger query paging
+const LedgerPageSize = 20
+const LedgerMaxPageSize = 100
+
+// Death causes
+const (
+	DeathNeglect    = "neglect"
+	DeathHygiene    = "hygiene"
+	DeathStarvation = "starvation"
+	DeathDisease    = "disease"
+)
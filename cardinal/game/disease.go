@@ -0,0 +1,105 @@
+package game
+
+import "slices"
+
+// Diseases. A pet whose hygiene or health runs low may fall sick: every SicknessTickRate ticks each low stat
+// rolls for the diseases it causes. A disease gets worse every ProgressTicks until MaxSeverity, when it starts
+// eating the pet's health, and only the items of the catalog curing it get rid of it (see `CatalogItem`).
+const (
+	DiseaseCold       = "Cold"
+	DiseaseFever      = "Fever"
+	DiseaseFleas      = "Fleas"
+	DiseaseStomachBug = "Stomach Bug"
+)
+
+// Classes of diseases, a vaccine protecting against a whole class
+const (
+	DiseaseViral     = "viral"
+	DiseaseBacterial = "bacterial"
+	DiseaseParasitic = "parasitic"
+)
+
+// DiseaseClasses are the classes of diseases an item can prevent.
+var DiseaseClasses = []string{DiseaseViral, DiseaseBacterial, DiseaseParasitic}
+
+// Sickness
+const (
+	SicknessTickRate   = TickMinute // Pets may fall sick and get worse once a minute
+	SicknessThreshold  = 40         // Hygiene or health below this makes a pet liable to fall sick
+	SicknessChanceRate = 4          // Every SicknessChanceRate points below the threshold add 1% of chance per roll
+	MaxSeverity        = 3
+)
+
+// DiseaseProperties declares the symptoms of a disease. Decline percentages are keyed by Stat* name and apply
+// in full at MaxSeverity, a third of the way above the usual rate at severity 1 of 3.
+type DiseaseProperties struct {
+	Name          string
+	Class         string
+	Cause         string         // The low stat the disease is caught from, `StatHygiene` or `StatHealth`
+	ProgressTicks uint64         // Ticks between two severities
+	Decline       map[string]int // Percentage of the usual decline of the stat
+	Blocks        []string       // Activities the pet is too sick for (see `ActivityKinds`)
+	Symptoms      []string       // Shown by the diagnosis
+}
+
+// Diseases holds every disease, by name.
+var Diseases = map[string]DiseaseProperties{
+	DiseaseCold: {Name: DiseaseCold, Class: DiseaseViral, Cause: StatHealth, ProgressTicks: TickHour * 2,
+		Decline:  map[string]int{StatEnergy: 200},
+		Symptoms: []string{"Sneezing", "Gets tired twice as fast"}},
+	DiseaseFever: {Name: DiseaseFever, Class: DiseaseViral, Cause: StatHealth, ProgressTicks: TickHour,
+		Decline:  map[string]int{StatEnergy: 150, StatWellness: 150},
+		Blocks:   []string{ActivityPlaying, ActivityTraining, ActivityPracticing},
+		Symptoms: []string{"Burning up", "Too weak to play or train"}},
+	DiseaseFleas: {Name: DiseaseFleas, Class: DiseaseParasitic, Cause: StatHygiene, ProgressTicks: TickHour * 3,
+		Decline:  map[string]int{StatHygiene: 200, StatWellness: 150},
+		Symptoms: []string{"Scratching all day", "Gets dirty twice as fast"}},
+	DiseaseStomachBug: {Name: DiseaseStomachBug, Class: DiseaseBacterial, Cause: StatHygiene, ProgressTicks: TickHour,
+		Decline:  map[string]int{StatSatiety: 200},
+		Symptoms: []string{"Tummy ache", "Gets hungry twice as fast"}},
+}
+
+// ImmunityTicks is how long a pet is protected by a preventive drug
+const ImmunityTicks = TickWeek
+
+// DiseaseByName returns the properties of a disease, and false for unknown diseases.
+func DiseaseByName(name string) (DiseaseProperties, bool) {
+	disease, ok := Diseases[name]
+	return disease, ok
+}
+
+// DiseasesCausedBy returns the names of the diseases caught from a low stat, sorted.
+func DiseasesCausedBy(stat string) []string {
+	var names []string
+	for name, disease := range Diseases {
+		if disease.Cause == stat {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// DeclinePercent returns the percentage of the usual decline of a stat for a pet with the disease at the given severity.
+func (d DiseaseProperties) DeclinePercent(stat string, severity int) int {
+	percent, ok := d.Decline[stat]
+	if !ok {
+		return 100
+	}
+	severity = min(max(severity, 1), MaxSeverity)
+	return 100 + (percent-100)*severity/MaxSeverity
+}
+
+// BlocksActivity reports whether the disease keeps a pet from the given activity.
+func (d DiseaseProperties) BlocksActivity(activity string) bool {
+	return slices.Contains(d.Blocks, activity)
+}
+
+// SicknessChance returns the percentage of chance to fall sick of a pet with the given value of a stat
+// causing diseases, 0 at or above SicknessThreshold.
+func SicknessChance(value int) int {
+	if value >= SicknessThreshold {
+		return 0
+	}
+	return (SicknessThreshold - value + SicknessChanceRate - 1) / SicknessChanceRate
+}
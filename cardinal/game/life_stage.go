@@ -0,0 +1,81 @@
+package game
+
+// Life stages, from youngest to oldest
+const (
+	StageEgg   = "Egg"
+	StageBaby  = "Baby"
+	StageChild = "Child"
+	StageTeen  = "Teen"
+	StageAdult = "Adult"
+	StageElder = "Elder"
+)
+
+// StageProperties holds the age threshold of a life stage and how fast a pet's stats decline during it.
+// Decline rates are percentages of the base decline of 1 point every `DeclineTickRate` ticks.
+type StageProperties struct {
+	Name            string
+	MinAge          uint64 // Ticks since `Pet.BornTick`
+	HealthDecline   int
+	EnergyDecline   int
+	HygieneDecline  int
+	WellnessDecline int
+	SatietyDecline  int
+	CanBreed        bool
+}
+
+// LifeStages must stay sorted by MinAge.
+var LifeStages = []StageProperties{
+	{Name: StageEgg, MinAge: 0, HealthDecline: 0, EnergyDecline: 0, HygieneDecline: 0, WellnessDecline: 0, SatietyDecline: 0},
+	{Name: StageBaby, MinAge: TickMinute * 10, HealthDecline: 100, EnergyDecline: 150, HygieneDecline: 150, WellnessDecline: 150, SatietyDecline: 150},
+	{Name: StageChild, MinAge: TickHour * 2, HealthDecline: 100, EnergyDecline: 125, HygieneDecline: 100, WellnessDecline: 125, SatietyDecline: 125},
+	{Name: StageTeen, MinAge: TickHour * 12, HealthDecline: 100, EnergyDecline: 100, HygieneDecline: 100, WellnessDecline: 100, SatietyDecline: 125},
+	{Name: StageAdult, MinAge: TickDay, HealthDecline: 100, EnergyDecline: 100, HygieneDecline: 100, WellnessDecline: 100, SatietyDecline: 100, CanBreed: true},
+	{Name: StageElder, MinAge: TickWeek, HealthDecline: 150, EnergyDecline: 125, HygieneDecline: 100, WellnessDecline: 100, SatietyDecline: 100, CanBreed: true},
+}
+
+// StageForAge returns the life stage of a pet that is `age` ticks old.
+func StageForAge(age uint64) StageProperties {
+	stage := LifeStages[0]
+	for _, s := range LifeStages {
+		if age >= s.MinAge {
+			stage = s
+		}
+	}
+	return stage
+}
+
+// StageIndex returns the position of a stage in LifeStages, or -1 if the stage is unknown.
+func StageIndex(name string) int {
+	for i, s := range LifeStages {
+		if s.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// StageByName returns the properties of a life stage. Unknown stages fall back to the egg stage.
+func StageByName(name string) StageProperties {
+	if i := StageIndex(name); i >= 0 {
+		return LifeStages[i]
+	}
+	return LifeStages[0]
+}
+
+// DeclineRate returns the decline percentage of the given stat (see the Stat* constants).
+func (s StageProperties) DeclineRate(stat string) int {
+	switch stat {
+	case StatHealth:
+		return s.HealthDecline
+	case StatEnergy:
+		return s.EnergyDecline
+	case StatHygiene:
+		return s.HygieneDecline
+	case StatWellness:
+		return s.WellnessDecline
+	case StatSatiety:
+		return s.SatietyDecline
+	default:
+		return 100
+	}
+}
@@ -0,0 +1,65 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Money is an amount of in-game currency in minor units. One Coin is 10000 minor units,
+// so the smallest amount a pet can earn (0.0001 coins) is exactly 1.
+type Money int64
+
+// Coin is the number of minor units in one coin.
+const Coin Money = 10000
+
+// MoneyUnits marks the components saving their amounts in minor units. Amounts saved without it are legacy
+// floating point coins.
+const MoneyUnits = "minor"
+
+// CoinsToMoney converts a legacy floating point coin amount to Money, rounding to the nearest minor unit.
+func CoinsToMoney(coins float64) Money {
+	return Money(math.Round(coins * float64(Coin)))
+}
+
+// Times returns the amount for quantity units, and an error when the total does not fit in Money.
+func (m Money) Times(quantity int) (Money, error) {
+	if quantity < 0 || (m > 0 && Money(quantity) > math.MaxInt64/m) || (m < 0 && Money(quantity) > math.MinInt64/m) {
+		return 0, fmt.Errorf("invalid amount %s times %d", m, quantity)
+	}
+	return m * Money(quantity), nil
+}
+
+// Percent returns percent of the amount, rounded down, computed so that it cannot overflow for percentages up to 100.
+func (m Money) Percent(percent int) Money {
+	return m/100*Money(percent) + m%100*Money(percent)/100
+}
+
+// String formats the amount in coins with all four decimals, e.g. "12.0005".
+func (m Money) String() string {
+	sign := ""
+	if m < 0 {
+		sign = "-"
+		m = -m
+	}
+	return fmt.Sprintf("%s%d.%04d", sign, m/Coin, m%Coin)
+}
+
+// DecodeMoney reads an amount saved in the given units, converting legacy floating point coins to Money.
+func DecodeMoney(amount json.Number, units string) (Money, error) {
+	if amount == "" {
+		return 0, nil
+	}
+	if units == MoneyUnits {
+		minor, err := amount.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("invalid amount %s: %w", amount, err)
+		}
+		return Money(minor), nil
+	}
+	coins, err := amount.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %s: %w", amount, err)
+	}
+	return CoinsToMoney(coins), nil
+}
@@ -0,0 +1,82 @@
+package game
+
+// Moods, from best to worst
+const (
+	MoodEcstatic  = "ecstatic"
+	MoodHappy     = "happy"
+	MoodNeutral   = "neutral"
+	MoodSad       = "sad"
+	MoodMiserable = "miserable"
+)
+
+// MoodTickRate is how often the mood of pets is computed, after their stats declined
+const MoodTickRate = DeclineTickRate
+
+// MaxMoodScore is the score of a pet with every stat at its max, e.g. a new pet
+const MaxMoodScore = 100
+
+// MoodWeights sets how much each stat counts in a pet's mood, by Stat* name. Stats missing from it do not count.
+var MoodWeights = map[string]int{
+	StatHealth:   3,
+	StatEnergy:   2,
+	StatHygiene:  2,
+	StatWellness: 3,
+}
+
+// MoodLevel is a mood and what it does to a pet. Percentages are of the usual experience and income.
+type MoodLevel struct {
+	Name          string
+	MinScore      int // See `MoodScore`
+	XPPercent     int // Experience earned playing
+	IncomePercent int // Money earned for the owner during activities
+}
+
+// MoodLevels must stay sorted by MinScore, best mood first.
+var MoodLevels = []MoodLevel{
+	{Name: MoodEcstatic, MinScore: 90, XPPercent: 150, IncomePercent: 150},
+	{Name: MoodHappy, MinScore: 70, XPPercent: 125, IncomePercent: 125},
+	{Name: MoodNeutral, MinScore: 45, XPPercent: 100, IncomePercent: 100},
+	{Name: MoodSad, MinScore: 25, XPPercent: 75, IncomePercent: 75},
+	{Name: MoodMiserable, MinScore: 0, XPPercent: 50, IncomePercent: 50},
+}
+
+// MoodScore returns the average of the stats weighted by `MoodWeights`, from 0 to 100.
+func MoodScore(stats map[string]int) int {
+	total, weights := 0, 0
+	for stat, weight := range MoodWeights {
+		value, ok := stats[stat]
+		if !ok {
+			continue
+		}
+		total += weight * value
+		weights += weight
+	}
+	if weights == 0 {
+		return 0
+	}
+	return total / weights
+}
+
+// MoodForScore returns the mood of a pet with the given score.
+func MoodForScore(score int) MoodLevel {
+	for _, mood := range MoodLevels {
+		if score >= mood.MinScore {
+			return mood
+		}
+	}
+	return MoodLevels[len(MoodLevels)-1]
+}
+
+// MoodByName returns the properties of a mood. Unknown moods fall back to the neutral mood.
+func MoodByName(name string) MoodLevel {
+	var neutral MoodLevel
+	for _, mood := range MoodLevels {
+		if mood.Name == name {
+			return mood
+		}
+		if mood.Name == MoodNeutral {
+			neutral = mood
+		}
+	}
+	return neutral
+}
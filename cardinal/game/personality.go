@@ -0,0 +1,82 @@
+package game
+
+// Personality traits. A pet has the trait of each of its Dna genes at or above TraitGeneThreshold,
+// so most pets have one or two traits and some have none.
+const (
+	TraitPlayful = "playful"
+	TraitLazy    = "lazy"
+	TraitClean   = "clean"
+	TraitGlutton = "glutton"
+)
+
+// TraitGeneThreshold is the gene value from which a pet has the trait of the gene
+const TraitGeneThreshold = 70
+
+// TraitProperties declares how a trait changes the way a pet lives. Percentages are keyed by Stat* name,
+// stats missing from a map are not affected.
+type TraitProperties struct {
+	Name    string
+	Gene    string         // The Dna gene giving the trait (see `component.DnaGenes`)
+	Decline map[string]int // Percentage of the usual decline of the stat
+	Care    map[string]int // Percentage of the effects of the items caring for the stat, e.g. food for satiety
+	Urgency map[string]int // Added to the urgency of the thoughts about the stat (see `UrgentThought`)
+}
+
+// PersonalityTraits holds every trait, in the order of the genes giving them.
+var PersonalityTraits = []TraitProperties{
+	{Name: TraitPlayful, Gene: "A",
+		Decline: map[string]int{StatWellness: 150}, Care: map[string]int{StatWellness: 150}, Urgency: map[string]int{StatWellness: 15}},
+	{Name: TraitLazy, Gene: "C",
+		Decline: map[string]int{StatEnergy: 50}, Care: map[string]int{StatWellness: 75}, Urgency: map[string]int{StatEnergy: 15}},
+	{Name: TraitClean, Gene: "G",
+		Decline: map[string]int{StatHygiene: 50}, Care: map[string]int{StatHygiene: 150}, Urgency: map[string]int{StatHygiene: 15}},
+	{Name: TraitGlutton, Gene: "T",
+		Decline: map[string]int{StatSatiety: 150}, Care: map[string]int{StatSatiety: 150}, Urgency: map[string]int{StatSatiety: 15}},
+}
+
+// TraitByName returns the properties of a trait, and false for names that are no personality trait
+// (e.g. the life stage among the traits of a `ThoughtState`).
+func TraitByName(name string) (TraitProperties, bool) {
+	for _, trait := range PersonalityTraits {
+		if trait.Name == name {
+			return trait, true
+		}
+	}
+	return TraitProperties{}, false
+}
+
+// TraitDeclinePercent returns the percentage of the usual decline of a stat for a pet with the given traits.
+func TraitDeclinePercent(traits []string, stat string) int {
+	return traitPercent(traits, func(t TraitProperties) map[string]int { return t.Decline }, stat)
+}
+
+// TraitCarePercent returns the percentage of the effects of the items caring for a stat on a pet with the given traits.
+func TraitCarePercent(traits []string, stat string) int {
+	return traitPercent(traits, func(t TraitProperties) map[string]int { return t.Care }, stat)
+}
+
+// TraitUrgency returns what the given traits add to the urgency of the thoughts about a stat.
+func TraitUrgency(traits []string, stat string) int {
+	urgency := 0
+	for _, name := range traits {
+		if trait, ok := TraitByName(name); ok {
+			urgency += trait.Urgency[stat]
+		}
+	}
+	return urgency
+}
+
+// traitPercent multiplies the percentages of the traits for a stat, 100 when no trait affects it.
+func traitPercent(traits []string, percents func(TraitProperties) map[string]int, stat string) int {
+	percent := 100
+	for _, name := range traits {
+		trait, ok := TraitByName(name)
+		if !ok {
+			continue
+		}
+		if p, ok := percents(trait)[stat]; ok {
+			percent = percent * p / 100
+		}
+	}
+	return percent
+}
@@ -0,0 +1,159 @@
+package game
+
+import "strings"
+
+// Thought engine. Every think cycle an idle pet gathers the thoughts matching its state and thinks the most
+// urgent one, so a starving pet complains about food before it complains about the time of day.
+// Thought texts are templates naming the pet ({pet}) and its owner ({owner}).
+
+// What a thought is about, besides the Stat* constants
+const (
+	ThoughtTime     = "time"     // The time of day
+	ThoughtEvent    = "event"    // Something that just happened to the pet
+	ThoughtActivity = "activity" // The activity the pet is engaged in
+)
+
+// MaxThoughtHistory is the number of thoughts a pet remembers
+const MaxThoughtHistory = 20
+
+// RecentEventTicks is how long a pet keeps thinking about something that happened to it
+const RecentEventTicks = TickMinute * 10
+
+// ThoughtRule is a thought a pet may have while the value of its Need is in Range.
+type ThoughtRule struct {
+	Need    string
+	Range   Range
+	Urgency int                 // The most urgent thought wins, the first rule declared on ties (see `TraitUrgency`)
+	Texts   []string            // One of them is picked at random
+	Traits  map[string][]string // Texts thought instead by pets with the trait, e.g. babies or gluttons
+
+	// Explanation tells the owner asking the pet what it needs (see `UrgentNeed`), empty for thoughts that are no need.
+	Explanation string
+}
+
+// ThoughtState is what a pet's thoughts are about.
+type ThoughtState struct {
+	Stats  map[string]int // By Stat* name
+	Hour   int            // See `Clock`
+	Event  string         // Something that just happened (see `ActivityEvent`), empty when nothing did
+	Traits []string       // Who the pet is: its personality traits, life stage, element and so on
+}
+
+// ThoughtRules holds the thoughts about the pet's stats and the time of day.
+var ThoughtRules = []ThoughtRule{
+	{Need: StatHealth, Range: Range{Min: 0, Max: 29}, Urgency: 100, Explanation: "{pet} is gravely ill, cure it right away.",
+		Texts:  []string{"Im gona dye!!!", "{owner}, please... I need a doctor."},
+		Traits: map[string][]string{StageBaby: {"Waaah! {pet} hurts all over!"}}},
+	{Need: StatSatiety, Range: Range{Min: 0, Max: StarvationThreshold}, Urgency: 90, Explanation: "{pet} is starving, feed it right away.",
+		Texts:  []string{"So hungry... Feed me, please!", "{owner}, I haven't eaten in ages!"},
+		Traits: map[string][]string{StageBaby: {"Hungwy! {pet} wants food!"}, TraitGlutton: {"FOOD. NOW. Please, {owner}!"}, "fire": {"I'm burning up with hunger!"}}},
+	{Need: StatHygiene, Range: Range{Min: 0, Max: 39}, Urgency: 80, Explanation: "{pet} is filthy, give it a bath.",
+		Texts:  []string{"OMG!!! Im really dirty! Someone please Bath me. Please!"},
+		Traits: map[string][]string{TraitClean: {"I can't stand being this dirty! {owner}, a bath, now!"}, "water": {"I miss the water... {owner}, a bath please!"}}},
+	{Need: StatHealth, Range: Range{Min: 30, Max: 40}, Urgency: 70, Explanation: "{pet} is not feeling well.",
+		Texts: []string{"I don't feel well."}},
+	{Need: StatEnergy, Range: Range{Min: 0, Max: 19}, Urgency: 60, Explanation: "{pet} is exhausted, let it sleep.",
+		Texts:  []string{"So tired... I can barely keep my eyes open."},
+		Traits: map[string][]string{StageElder: {"These old bones need a nap, {owner}."}}},
+	{Need: StatWellness, Range: Range{Min: 0, Max: 29}, Urgency: 55, Explanation: "{pet} is unhappy, play with it.",
+		Texts:  []string{"Dont know what to do...", "Does {owner} still love me?"},
+		Traits: map[string][]string{TraitPlayful: {"Nobody wants to play with me anymore..."}}},
+	{Need: StatSatiety, Range: Range{Min: StarvationThreshold + 1, Max: 50}, Urgency: 50, Explanation: "{pet} is getting hungry.",
+		Texts:  []string{"My tummy is rumbling."},
+		Traits: map[string][]string{TraitGlutton: {"Snack time? It's always snack time, {owner}!"}}},
+	{Need: StatHygiene, Range: Range{Min: 40, Max: 60}, Urgency: 40, Explanation: "{pet} could use a bath.",
+		Texts:  []string{"My whole body itches"},
+		Traits: map[string][]string{TraitClean: {"Ew, a speck of dirt! Bath time, {owner}?"}}},
+	{Need: StatEnergy, Range: Range{Min: 20, Max: 60}, Urgency: 30, Explanation: "{pet} is getting tired.",
+		Texts:  []string{"Im bored... to death? Play with me!"},
+		Traits: map[string][]string{TraitLazy: {"Five more minutes... or five more hours."}, "wynd": {"I want to run with the wind! Play with me, {owner}!"}}},
+	{Need: StatWellness, Range: Range{Min: 30, Max: 70}, Urgency: 25, Explanation: "{pet} is a little down.",
+		Texts: []string{"I fell a little depress today."}},
+	{Need: StatEnergy, Range: Range{Min: 70, Max: 80}, Urgency: 20,
+		Texts:  []string{"Im bored. I would kill to go outside."},
+		Traits: map[string][]string{TraitPlayful: {"Let's play, {owner}! Let's play, let's play!"}, "earth": {"I want to dig in the garden!"}}},
+	{Need: ThoughtTime, Range: Range{Min: 0, Max: DayStartHour - 1}, Urgency: 15,
+		Texts: []string{"Why am I awake? It's the middle of the night."}},
+	{Need: ThoughtTime, Range: Range{Min: NightStartHour, Max: 23}, Urgency: 10,
+		Texts: []string{"*yawn* It's getting late..."}},
+	{Need: ThoughtTime, Range: Range{Min: DayStartHour, Max: DayStartHour + 2}, Urgency: 10,
+		Texts:  []string{"Good morning {owner}! What are we doing today?"},
+		Traits: map[string][]string{StageElder: {"Up with the sun, as always."}}},
+}
+
+// Outcomes of an activity, see `ActivityEvent`
+const (
+	EventCompleted = "completed"
+	EventCancelled = "cancelled"
+)
+
+// ActivityEvent names the event of an activity ending, e.g. "completed Eating".
+func ActivityEvent(outcome string, activity string) string {
+	return outcome + " " + activity
+}
+
+// EventThoughts holds the thoughts about recent events, by event (see `ActivityEvent`).
+// They are more urgent than mild needs and less urgent than pressing ones.
+var EventThoughts = map[string]ThoughtRule{
+	ActivityEvent(EventCompleted, ActivityEating):   {Need: ThoughtEvent, Urgency: 45, Texts: []string{"That was delicious, thank you {owner}!"}},
+	ActivityEvent(EventCompleted, ActivityBathing):  {Need: ThoughtEvent, Urgency: 45, Texts: []string{"Squeaky clean!"}},
+	ActivityEvent(EventCompleted, ActivityPlaying):  {Need: ThoughtEvent, Urgency: 45, Texts: []string{"That was fun! Again, {owner}, again!"}},
+	ActivityEvent(EventCompleted, ActivitySleeping): {Need: ThoughtEvent, Urgency: 45, Texts: []string{"What a good night's sleep!"}},
+	ActivityEvent(EventCancelled, ActivitySleeping): {Need: ThoughtEvent, Urgency: 45, Texts: []string{"Grr... {owner} woke me up."}},
+	ActivityEvent(EventCompleted, ActivityVacation): {Need: ThoughtEvent, Urgency: 45, Texts: []string{"Home sweet home! I missed you, {owner}."}},
+	ActivityEvent(EventCancelled, ActivityVacation): {Need: ThoughtEvent, Urgency: 45, Texts: []string{"Back already? I missed you, {owner}."}},
+	ActivityEvent(EventCompleted, ActivityDaycare):  {Need: ThoughtEvent, Urgency: 45, Texts: []string{"The daycare was nice, but I'd rather be with {owner}."}},
+}
+
+// UrgentThought returns the most urgent thought of a pet in the given state, and false when nothing is on its mind.
+func UrgentThought(state ThoughtState) (ThoughtRule, bool) {
+	best, found := urgentRule(state, func(rule ThoughtRule) bool { return true })
+	if rule, ok := EventThoughts[state.Event]; ok && (!found || rule.Urgency > best.Urgency) {
+		return rule, true
+	}
+	return best, found
+}
+
+// UrgentNeed returns the thought about the most pressing need of a pet, leaving events and the time of day aside,
+// and false when the pet needs nothing.
+func UrgentNeed(state ThoughtState) (ThoughtRule, bool) {
+	return urgentRule(state, func(rule ThoughtRule) bool { return rule.Explanation != "" })
+}
+
+// urgentRule returns the most urgent of the `ThoughtRules` matching the state and kept by the filter,
+// its urgency raised for the pet's personality traits.
+func urgentRule(state ThoughtState, keep func(ThoughtRule) bool) (ThoughtRule, bool) {
+	var best ThoughtRule
+	found := false
+	for _, rule := range ThoughtRules {
+		if !keep(rule) {
+			continue
+		}
+		value, ok := state.Stats[rule.Need]
+		if rule.Need == ThoughtTime {
+			value, ok = state.Hour, true
+		}
+		urgency := rule.Urgency + TraitUrgency(state.Traits, rule.Need)
+		if ok && rule.Range.Contains(value) && (!found || urgency > best.Urgency) {
+			best = rule
+			best.Urgency = urgency
+			found = true
+		}
+	}
+	return best, found
+}
+
+// TextsFor returns the texts a pet with the given traits may think, the texts of its first matching trait if any.
+func (r ThoughtRule) TextsFor(traits []string) []string {
+	for _, trait := range traits {
+		if texts, ok := r.Traits[trait]; ok {
+			return texts
+		}
+	}
+	return r.Texts
+}
+
+// FormatThought fills the pet's nickname and its owner's persona tag into a thought template.
+func FormatThought(template string, nickname string, owner string) string {
+	return strings.NewReplacer("{pet}", nickname, "{owner}", owner).Replace(template)
+}
@@ -4,6 +4,7 @@ go 1.22.1
 
 require (
 	github.com/rs/zerolog v1.33.0
+	github.com/stretchr/testify v1.10.0
 	gotest.tools/v3 v3.5.1
 	pkg.world.dev/world-engine/cardinal v1.7.1
 )
@@ -92,7 +93,6 @@ require (
 	github.com/spf13/pflag v1.0.5 // indirect
 	github.com/spf13/viper v1.18.2 // indirect
 	github.com/stretchr/objx v0.5.2 // indirect
-	github.com/stretchr/testify v1.10.0 // indirect
 	github.com/subosito/gotenv v1.6.0 // indirect
 	github.com/swaggo/files/v2 v2.0.0 // indirect
 	github.com/swaggo/swag v1.16.3 // indirect
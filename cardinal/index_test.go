@@ -0,0 +1,195 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+// TestIndex_FindsCreatedEntities tests that players, pets and store items are added to the Index when they are created.
+func TestIndex_FindsCreatedEntities(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// When:
+	// - A persona, a player, two pets and their child are created.
+	createPersona(t, tf, personaTag)
+	assert.NoError(t, createPlayer(t, tf, personaTag))
+	assert.NoError(t, createPet(t, tf, motherName, personaTag))
+	assert.NoError(t, createPet(t, tf, fatherName, personaTag))
+	setPetLifeStage(t, tf, motherName, game.StageAdult)
+	setPetLifeStage(t, tf, fatherName, game.StageAdult)
+	assert.NoError(t, PetBreedAction(t, tf, motherName, fatherName, childName))
+
+	// Then:
+	// - The Index holds the entity of each of them.
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+	_, index := findIndex(t, wCtx)
+	for _, nickname := range []string{motherName, fatherName, childName} {
+		found, petId, err := component.QueryPetIdByName(wCtx, nickname)
+		assert.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, petId, index.Pets[nickname])
+	}
+	playerId, err := component.FindPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, playerId, index.Players[personaTag])
+
+	// - Every store item is indexed.
+	itemId, err := component.FindItemByName(wCtx, foodName)
+	assert.NoError(t, err)
+	assert.Equal(t, itemId, index.Items[foodName])
+
+	// - Names that were never created are not found.
+	found, _, err := component.QueryPetIdByName(wCtx, "Nobody")
+	assert.NoError(t, err)
+	assert.False(t, found)
+	_, err = component.FindPlayerByPersonaTag(wCtx, "nobody")
+	assert.Error(t, err)
+}
+
+// TestIndex_BuiltForExistingWorld tests that a world without an Index gets one holding its existing entities.
+func TestIndex_BuiltForExistingWorld(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized with a player.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	createPersona(t, tf, personaTag)
+	assert.NoError(t, createPlayer(t, tf, personaTag))
+
+	// - The Index is removed, as in a world created before it existed, and a pet is created without it.
+	wCtx := cardinal.NewWorldContext(tf.World)
+	indexId, _ := findIndex(t, wCtx)
+	assert.NoError(t, cardinal.Remove(wCtx, indexId))
+	petId, err := component.CreateRandomPet(wCtx, personaTag, petName)
+	assert.NoError(t, err)
+
+	// When:
+	// - A tick runs.
+	tf.DoTick()
+
+	// Then:
+	// - The Index is created again with the player and the pet.
+	_, index := findIndex(t, cardinal.NewReadOnlyWorldContext(tf.World))
+	assert.Equal(t, petId, index.Pets[petName])
+	assert.Contains(t, index.Players, personaTag)
+	assert.Contains(t, index.Items, foodName)
+}
+
+// findIndex returns the Index entity of the world.
+func findIndex(t *testing.T, wCtx cardinal.WorldContext) (types.EntityID, *component.Index) {
+	var indexId types.EntityID
+	err := cardinal.NewSearch().Entity(filter.Exact(filter.Component[component.Index]())).Each(wCtx, func(id types.EntityID) bool {
+		indexId = id
+		return false
+	})
+	assert.NoError(t, err)
+	index, err := cardinal.GetComponent[component.Index](wCtx, indexId)
+	if err != nil {
+		t.Fatalf("world has no Index: %v", err)
+	}
+	return indexId, index
+}
+
+const (
+	benchmarkPlayers       = 10_000
+	benchmarkPetsPerPlayer = 5
+)
+
+// BenchmarkTick_10kPlayers50kPets measures the time of a decline cycle (`game.DeclineTickRate` ticks)
+// in a world of 10k players owning 50k pets, each engaged in an activity.
+func BenchmarkTick_10kPlayers50kPets(b *testing.B) {
+	tf := newBenchmarkWorld(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for tick := 0; tick < game.DeclineTickRate; tick++ {
+			tf.DoTick()
+		}
+	}
+	b.ReportMetric(float64(b.Elapsed().Nanoseconds())/float64(b.N*game.DeclineTickRate), "ns/tick")
+}
+
+// BenchmarkLookup_PetByNickname measures finding one pet by nickname among 50k pets.
+func BenchmarkLookup_PetByNickname(b *testing.B) {
+	tf := newBenchmarkWorld(b)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+	nickname := benchmarkPetName(benchmarkPlayers-1, benchmarkPetsPerPlayer-1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if found, _, err := component.QueryPetIdByName(wCtx, nickname); err != nil || !found {
+			b.Fatalf("pet %s not found: %v", nickname, err)
+		}
+	}
+}
+
+// BenchmarkLookup_PlayerByPersonaTag measures finding one player by persona tag among 10k players.
+func BenchmarkLookup_PlayerByPersonaTag(b *testing.B) {
+	tf := newBenchmarkWorld(b)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+	tag := benchmarkPersonaTag(benchmarkPlayers - 1)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := component.FindPlayerByPersonaTag(wCtx, tag); err != nil {
+			b.Fatalf("player %s not found: %v", tag, err)
+		}
+	}
+}
+
+// newBenchmarkWorld creates a world with `benchmarkPlayers` players owning `benchmarkPetsPerPlayer` pets each.
+// The entities are created directly rather than through transactions, which would take one tick per entity.
+func newBenchmarkWorld(b *testing.B) *cardinal.TestFixture {
+	b.Helper()
+	tf := cardinal.NewTestFixture(b, nil)
+	MustInitWorld(tf.World)
+	tf.DoTick()
+
+	wCtx := cardinal.NewWorldContext(tf.World)
+	for i := 0; i < benchmarkPlayers; i++ {
+		tag := benchmarkPersonaTag(i)
+		playerId, err := cardinal.Create(wCtx,
+			component.Player{PersonaTag: tag, Pets: make([]types.EntityID, 0), Inventory: make([]component.ItemStack, 0), Money: game.PlayerInitialMoney},
+			component.Ledger{Entries: make([]component.LedgerEntry, 0)},
+		)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if err := component.IndexPlayer(wCtx, tag, playerId); err != nil {
+			b.Fatal(err)
+		}
+		for j := 0; j < benchmarkPetsPerPlayer; j++ {
+			petId, err := component.CreateRandomPet(wCtx, tag, benchmarkPetName(i, j))
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := component.AddPlayerPet(wCtx, playerId, petId); err != nil {
+				b.Fatal(err)
+			}
+			activity := &component.Activity{Activity: "Playing", CountDown: game.TickDay, TotalTicks: game.TickDay, Percentage: 100}
+			if err := cardinal.SetComponent(wCtx, petId, activity); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+	tf.DoTick()
+	return tf
+}
+
+func benchmarkPersonaTag(player int) string {
+	return fmt.Sprintf("player-%d", player)
+}
+
+func benchmarkPetName(player int, pet int) string {
+	return fmt.Sprintf("pet-%d-%d", player, pet)
+}
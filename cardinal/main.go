@@ -39,17 +39,36 @@ func MustInitWorld(w *cardinal.World) {
 		cardinal.RegisterComponent[component.DrugStore](w),
 		cardinal.RegisterComponent[component.FoodStore](w),
 		cardinal.RegisterComponent[component.Player](w),
+		cardinal.RegisterComponent[component.Ledger](w),
 		cardinal.RegisterComponent[component.Pet](w),
 		cardinal.RegisterComponent[component.Item](w),
 		cardinal.RegisterComponent[component.Dna](w),
+		cardinal.RegisterComponent[component.Personality](w),
+		cardinal.RegisterComponent[component.Mood](w),
+		cardinal.RegisterComponent[component.Sickness](w),
 		cardinal.RegisterComponent[component.Health](w),
 		cardinal.RegisterComponent[component.Energy](w),
 		cardinal.RegisterComponent[component.Hygiene](w),
 		cardinal.RegisterComponent[component.Wellness](w),
+		cardinal.RegisterComponent[component.Hunger](w),
 		cardinal.RegisterComponent[component.Activity](w),
 		cardinal.RegisterComponent[component.Think](w),
 		cardinal.RegisterComponent[component.Magic](w),
 		cardinal.RegisterComponent[component.Skill](w),
+		cardinal.RegisterComponent[component.Lineage](w),
+		cardinal.RegisterComponent[component.LifeStage](w),
+		cardinal.RegisterComponent[component.Deceased](w),
+		cardinal.RegisterComponent[component.Revive](w),
+		cardinal.RegisterComponent[component.Trade](w),
+		cardinal.RegisterComponent[component.Listing](w),
+		cardinal.RegisterComponent[component.CatalogVersion](w),
+		cardinal.RegisterComponent[component.Battle](w),
+		cardinal.RegisterComponent[component.BattleLog](w),
+		cardinal.RegisterComponent[component.ItemEffects](w),
+		cardinal.RegisterComponent[component.Buffs](w),
+		cardinal.RegisterComponent[component.Index](w),
+		cardinal.RegisterComponent[component.ActivityQueue](w),
+		cardinal.RegisterComponent[component.Thoughts](w),
 	)
 
 	// Register messages (user action)
@@ -61,8 +80,25 @@ func MustInitWorld(w *cardinal.World) {
 		cardinal.RegisterMessage[msg.SleepPetMsg, msg.SleepPetMsgReply](w, "sleep-pet"),
 		cardinal.RegisterMessage[msg.BathPetMsg, msg.BathPetMsgReply](w, "bath-pet"),
 		cardinal.RegisterMessage[msg.FeedPetMsg, msg.FeedPetMsgReply](w, "feed-pet"),
+		cardinal.RegisterMessage[msg.UseItemMsg, msg.UseItemMsgReply](w, "use-item"),
+		cardinal.RegisterMessage[msg.CancelActivityMsg, msg.CancelActivityMsgReply](w, "cancel-activity"),
+		cardinal.RegisterMessage[msg.EnqueueActivityMsg, msg.EnqueueActivityMsgReply](w, "enqueue-activity"),
+		cardinal.RegisterMessage[msg.ClearQueueMsg, msg.ClearQueueMsgReply](w, "clear-queue"),
+		cardinal.RegisterMessage[msg.VacationModeMsg, msg.VacationModeMsgReply](w, "vacation-mode"),
+		cardinal.RegisterMessage[msg.ThinkPetMsg, msg.ThinkPetMsgReply](w, "think-pet"),
 		cardinal.RegisterMessage[msg.BreedPetMsg, msg.BreedPetMsgReply](w, "breed-pet"),
 		cardinal.RegisterMessage[msg.ButItemMsg, msg.BuyItemMsgReply](w, "buy-item"),
+		cardinal.RegisterMessage[msg.SellItemMsg, msg.SellItemMsgReply](w, "sell-item"),
+		cardinal.RegisterMessage[msg.RevivePetMsg, msg.RevivePetMsgReply](w, "revive-pet"),
+		cardinal.RegisterMessage[msg.ProposeTradeMsg, msg.ProposeTradeMsgReply](w, "propose-trade"),
+		cardinal.RegisterMessage[msg.AcceptTradeMsg, msg.AcceptTradeMsgReply](w, "accept-trade"),
+		cardinal.RegisterMessage[msg.CancelTradeMsg, msg.CancelTradeMsgReply](w, "cancel-trade"),
+		cardinal.RegisterMessage[msg.ListForSaleMsg, msg.ListForSaleMsgReply](w, "list-for-sale"),
+		cardinal.RegisterMessage[msg.BuyListingMsg, msg.BuyListingMsgReply](w, "buy-listing"),
+		cardinal.RegisterMessage[msg.ChallengePetMsg, msg.ChallengePetMsgReply](w, "challenge-pet"),
+		cardinal.RegisterMessage[msg.AcceptChallengeMsg, msg.AcceptChallengeMsgReply](w, "accept-challenge"),
+		cardinal.RegisterMessage[msg.TrainSkillMsg, msg.TrainSkillMsgReply](w, "train-skill"),
+		cardinal.RegisterMessage[msg.PracticeMagicMsg, msg.PracticeMagicMsgReply](w, "practice-magic"),
 	)
 
 	// Register queries
@@ -77,6 +113,19 @@ func MustInitWorld(w *cardinal.World) {
 		cardinal.RegisterQuery[query.ItemListMsg, query.ItemListReply](w, "personaItem-list", query.QueryPlayerItems),
 		cardinal.RegisterQuery[query.PlayerExistMsg, query.PlayerExistReply](w, "player-exist", query.QueryPlayerExist),
 		cardinal.RegisterQuery[query.LeaderboardMsg, query.LeaderboardReply](w, "leaderboard", query.QueryLeaderboard),
+		cardinal.RegisterQuery[query.PetLineageRequest, query.PetLineageResponse](w, "pet-lineage", query.QueryPetLineage),
+		cardinal.RegisterQuery[query.GraveyardMsg, query.GraveyardReply](w, "graveyard", query.QueryGraveyard),
+		cardinal.RegisterQuery[query.PlayerLedgerRequest, query.PlayerLedgerResponse](w, "player-ledger", query.QueryPlayerLedger),
+		cardinal.RegisterQuery[query.PendingTradesRequest, query.PendingTradesResponse](w, "pending-trades", query.QueryPendingTrades),
+		cardinal.RegisterQuery[query.MarketListingsRequest, query.MarketListingsResponse](w, "market-listings", query.QueryMarketListings),
+		cardinal.RegisterQuery[query.BattleLogRequest, query.BattleLogResponse](w, "battle-log", query.QueryBattleLog),
+		cardinal.RegisterQuery[query.PetAbilitiesRequest, query.PetAbilitiesResponse](w, "pet-abilities", query.QueryPetAbilities),
+		cardinal.RegisterQuery[query.PetStatusRequest, query.PetStatusResponse](w, "pet-status", query.QueryPetStatus),
+		cardinal.RegisterQuery[query.PlayerPetsRequest, query.PlayerPetsResponse](w, "player-pets", query.QueryPlayerPets),
+		cardinal.RegisterQuery[query.PetQueueRequest, query.PetQueueResponse](w, "pet-queue", query.QueryPetQueue),
+		cardinal.RegisterQuery[query.PetThoughtsRequest, query.PetThoughtsResponse](w, "pet-thoughts", query.QueryPetThoughts),
+		cardinal.RegisterQuery[query.WorldClockRequest, query.WorldClockResponse](w, "world-clock", query.QueryWorldClock),
+		cardinal.RegisterQuery[query.PetDiagnosisRequest, query.PetDiagnosisResponse](w, "pet-diagnosis", query.QueryPetDiagnosis),
 	)
 
 	// Each system executes deterministically in the order they are added.
@@ -84,6 +133,10 @@ func MustInitWorld(w *cardinal.World) {
 	// For example, you may want to run the attack system before the regen system
 	// so that the player's HP is subtracted (and player killed if it reaches 0) before HP is regenerated.
 	Must(cardinal.RegisterSystems(w,
+		// Maintain the Index before any system looks an entity up
+		game.IndexSystem,
+		// Bring the store items in line with the item catalog before anything is bought
+		game.CatalogSystem,
 		game.LeaderboardSystem,
 		// Create Actors
 		actions.PetSpawnerAction,
@@ -94,15 +147,40 @@ func MustInitWorld(w *cardinal.World) {
 		actions.PetBathAction,
 		actions.PetSleepAction,
 		actions.PetFeedAction,
+		actions.PetUseItemAction,
+		actions.CancelActivityAction,
+		actions.EnqueueActivityAction,
+		actions.ClearQueueAction,
+		actions.VacationModeAction,
+		actions.PetThinkAction,
 		actions.PetBreedAction,
+		actions.PetReviveAction,
+		actions.PetTrainSkillAction,
+		actions.PetPracticeMagicAction,
 		actions.BuyItemAction,
+		actions.SellItemAction,
+		actions.ProposeTradeAction,
+		actions.AcceptTradeAction,
+		actions.CancelTradeAction,
+		actions.ListForSaleAction,
+		actions.BuyListingAction,
+		actions.ChallengePetAction,
+		actions.AcceptChallengeAction,
 		// Execute Game mechanics
+		mechanics.LifeStageSystem,
+		mechanics.BuffSystem,
+		mechanics.SicknessSystem,
 		mechanics.EnergyDeclineSystem,
 		mechanics.HygieneDeclineSystem,
 		mechanics.WellnessDeclineSystem,
+		mechanics.HungerDeclineSystem,
 		mechanics.HealthDeclineSystem,
+		mechanics.MoodSystem,
+		mechanics.BattleSystem,
 		mechanics.ActivityDeclineSystem,
 		mechanics.ThinkSystem,
+		mechanics.TradeExpirySystem,
+		mechanics.ListingExpirySystem,
 	))
 
 	Must(cardinal.RegisterInitSystems(w,
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+// TestSystem_Market_BuyListing tests that a bought listing moves the goods to the buyer and pays the seller minus the fee.
+func TestSystem_Market_BuyListing(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - Two players are created, the first owning a pet and two units of food.
+	setupTraders(t, tf)
+	before, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+
+	// - The first player lists both units of food.
+	price := 2 * game.Coin
+	listed, err := executeTx[msg.ListForSaleMsgReply](t, tf, listForSaleMsgName, msg.ListForSaleMsg{ItemName: foodName, Quantity: 2, Price: price}, personaTag)
+	assert.NoError(t, err)
+
+	// - The listing only matches its kind and price range.
+	listings, err := query.QueryMarketListings(wCtx, &query.MarketListingsRequest{Kind: "Food", MaxPrice: price})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listings.Total)
+	assert.Equal(t, listed.ListingID, listings.Listings[0].ListingID)
+	assert.Equal(t, 2, listings.Listings[0].Quantity)
+	listings, err = query.QueryMarketListings(wCtx, &query.MarketListingsRequest{Kind: component.ListingKindPet})
+	assert.NoError(t, err)
+	assert.Zero(t, listings.Total)
+	listings, err = query.QueryMarketListings(wCtx, &query.MarketListingsRequest{MinPrice: price + 1})
+	assert.NoError(t, err)
+	assert.Zero(t, listings.Total)
+
+	// - A page past the end is empty, however far.
+	listings, err = query.QueryMarketListings(wCtx, &query.MarketListingsRequest{Page: math.MaxInt})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, listings.Total)
+	assert.Empty(t, listings.Listings)
+
+	// When:
+	// - The seller tries to buy the listing, then the second player buys it.
+	_, ownErr := executeTx[msg.BuyListingMsgReply](t, tf, buyListingMsgName, msg.BuyListingMsg{ListingID: listed.ListingID}, personaTag)
+	reply, err := executeTx[msg.BuyListingMsgReply](t, tf, buyListingMsgName, msg.BuyListingMsg{ListingID: listed.ListingID}, traderTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The seller cannot buy their own listing.
+	assert.Error(t, ownErr)
+
+	// - The buyer paid the price and owns the food.
+	assert.Equal(t, price, reply.Paid)
+	itemId, err := component.FindItemByName(wCtx, foodName)
+	assert.NoError(t, err)
+	trader, err := component.GetPlayerByPersonaTag(wCtx, traderTag)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, trader.ItemQuantity(itemId))
+	assert.Equal(t, game.PlayerInitialMoney-price, trader.Money)
+
+	// - The seller received the price minus the market fee.
+	seller, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.False(t, seller.HasItem(itemId))
+	assert.Equal(t, before.Money+price-price*game.MarketFeePercent/100, seller.Money)
+
+	// - The listing is gone.
+	listings, err = query.QueryMarketListings(wCtx, &query.MarketListingsRequest{})
+	assert.NoError(t, err)
+	assert.Zero(t, listings.Total)
+}
+
+// TestSystem_Market_SellerProceedsMaxPrice tests that the market fee of the highest price does not overflow.
+func TestSystem_Market_SellerProceedsMaxPrice(t *testing.T) {
+	listing := component.Listing{Price: math.MaxInt64}
+	assert.Equal(t, game.Money(math.MaxInt64-math.MaxInt64/100*game.MarketFeePercent), listing.SellerProceeds())
+	listing.Price = 99
+	assert.Equal(t, game.Money(95), listing.SellerProceeds())
+}
+
+// TestSystem_Market_ListingExpires tests that a listed pet is returned to its seller when the listing expires,
+// its stats frozen while it was listed.
+func TestSystem_Market_ListingExpires(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - Two players are created and the first lists its pet, dirty and about to die.
+	setupTraders(t, tf)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Health{HP: 5}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hygiene{Hy: 0}))
+	listed, err := executeTx[msg.ListForSaleMsgReply](t, tf, listForSaleMsgName, msg.ListForSaleMsg{PetName: petName, Price: game.Coin}, personaTag)
+	assert.NoError(t, err)
+
+	// - The pet is held by the market.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.False(t, player.HasPet(petId))
+
+	// - The listing expires after many decline cycles.
+	listing, err := component.GetListing(wCtx, listed.ListingID)
+	assert.NoError(t, err)
+	listing.ExpiresTick = tf.World.CurrentTick() + 20*game.DeclineTickRate
+	assert.NoError(t, cardinal.SetComponent(wCtx, listed.ListingID, listing))
+
+	// When:
+	// - The listing runs to its expiry.
+	for i := 0; i <= 20*game.DeclineTickRate; i++ {
+		tf.DoTick()
+	}
+
+	// Then:
+	// - The pet is back with its seller, alive and with the stats it had when it was listed,
+	//   and the listing can no longer be bought.
+	player, err = component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.True(t, player.HasPet(petId))
+	assert.False(t, component.IsPetDeceased(wCtx, petId))
+	health, err := cardinal.GetComponent[component.Health](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, health.HP)
+	_, err = executeTx[msg.BuyListingMsgReply](t, tf, buyListingMsgName, msg.BuyListingMsg{ListingID: listed.ListingID}, traderTag)
+	assert.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+// TestMood_WeightedScore tests that the mood score weighs the stats by `game.MoodWeights` and picks the mood of
+// the score.
+func TestMood_WeightedScore(t *testing.T) {
+	// When / Then:
+	// - A pet with every stat at its max is ecstatic.
+	full := map[string]int{game.StatHealth: 100, game.StatEnergy: 100, game.StatHygiene: 100, game.StatWellness: 100}
+	assert.Equal(t, game.MaxMoodScore, game.MoodScore(full))
+	assert.Equal(t, game.MoodEcstatic, game.MoodForScore(game.MoodScore(full)).Name)
+
+	// - Health and wellness weigh more than energy and hygiene, and satiety does not count.
+	tired := map[string]int{game.StatHealth: 100, game.StatEnergy: 0, game.StatHygiene: 100, game.StatWellness: 100, game.StatSatiety: 0}
+	ill := map[string]int{game.StatHealth: 0, game.StatEnergy: 100, game.StatHygiene: 100, game.StatWellness: 100, game.StatSatiety: 100}
+	assert.Equal(t, 80, game.MoodScore(tired))
+	assert.Equal(t, 70, game.MoodScore(ill))
+	assert.Equal(t, game.MoodHappy, game.MoodForScore(game.MoodScore(ill)).Name)
+
+	// - The lowest scores are miserable, and unknown moods behave as neutral.
+	assert.Equal(t, game.MoodMiserable, game.MoodForScore(0).Name)
+	assert.Equal(t, 100, game.MoodByName("grumpy").XPPercent)
+}
+
+// TestSystem_MoodSystem_FollowsStats tests that a pet's mood is derived from its stats every decline cycle and shows
+// in its status.
+func TestSystem_MoodSystem_FollowsStats(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a pet are created. The new pet is ecstatic.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	mood, ok := component.GetPetMood(wCtx, petId)
+	assert.True(t, ok)
+	assert.Equal(t, game.MoodEcstatic, mood.Mood)
+
+	// When:
+	// - The pet gets ill, tired, dirty and unhappy, and a decline cycle passes.
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Health{HP: 10}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Energy{E: 10}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hygiene{Hy: 10}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Wellness{Wn: 10}))
+	for i := 0; i < game.MoodTickRate; i++ {
+		tf.DoTick()
+	}
+
+	// Then:
+	// - The pet is miserable.
+	mood, ok = component.GetPetMood(wCtx, petId)
+	assert.True(t, ok)
+	assert.Equal(t, game.MoodMiserable, mood.Mood)
+	assert.LessOrEqual(t, mood.Score, 10)
+
+	// - The pet status shows the mood.
+	status, err := query.QueryPetStatus(wCtx, &query.PetStatusRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Equal(t, game.MoodMiserable, status.Status.Mood.Mood)
+}
+
+// TestSystem_PetPlayAction_MoodScalesXP tests that a pet learns less from playing when it is sad.
+func TestSystem_PetPlayAction_MoodScalesXP(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a sad pet are created, and the player buys a toy.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	assert.NoError(t, buyToy(t, tf, playToyName))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	sad := component.NewMood(game.MoodByName(game.MoodSad).MinScore, wCtx.CurrentTick())
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &sad))
+
+	// When:
+	// - The pet plays.
+	reply, err := executeTx[msg.PlayPetMsgReply](t, tf, playMsgName,
+		msg.PlayPetMsg{TargetNickname: petName, ItemName: playToyName}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet earned the sad share of the usual experience.
+	expected := int64(game.ExperienceEarn * game.MoodByName(game.MoodSad).XPPercent / 100)
+	assert.Less(t, expected, int64(game.ExperienceEarn))
+	assert.Equal(t, expected, reply.XP)
+}
@@ -0,0 +1,34 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+/**
+ * Function Flow:
+ * 1. The AcceptChallengeMsg structure is created to hold the battle ID for the accept challenge action.
+ * 2. The AcceptChallengeMsgReply structure is created to hold the reply data for the accept challenge action.
+ *
+ * This package provides message structures for the accept challenge action.
+ */
+type AcceptChallengeMsg struct {
+	/**
+	 * BattleID is the ID of the battle to be accepted.
+	 */
+	BattleID types.EntityID `json:"battle_id"`
+}
+
+/**
+ * Function Flow:
+ * 1. The AcceptChallengeMsgReply structure is created to hold the reply data for the accept challenge action.
+ * 2. The Success field holds the success status of the accept challenge action.
+ *
+ * This structure provides the reply data for the accept challenge action.
+ */
+type AcceptChallengeMsgReply struct {
+	/**
+	 * Success is the success status of the accept challenge action.
+	 */
+	Success bool `json:"success"`
+}
+
+// accept_challenge_msg.go
@@ -0,0 +1,34 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+/**
+ * Function Flow:
+ * 1. The AcceptTradeMsg structure is created to hold the trade ID for the accept trade action.
+ * 2. The AcceptTradeMsgReply structure is created to hold the reply data for the accept trade action.
+ *
+ * This package provides message structures for the accept trade action.
+ */
+type AcceptTradeMsg struct {
+	/**
+	 * TradeID is the ID of the trade to be accepted.
+	 */
+	TradeID types.EntityID `json:"trade_id"`
+}
+
+/**
+ * Function Flow:
+ * 1. The AcceptTradeMsgReply structure is created to hold the reply data for the accept trade action.
+ * 2. The Success field holds the success status of the accept trade action.
+ *
+ * This structure provides the reply data for the accept trade action.
+ */
+type AcceptTradeMsgReply struct {
+	/**
+	 * Success is the success status of the accept trade action.
+	 */
+	Success bool `json:"success"`
+}
+
+// accept_trade_msg.go
@@ -22,7 +22,7 @@ type BathPetMsg struct {
 /**
  * Function Flow:
  * 1. The BathPetMsgReply structure is created to hold the reply data for the bath pet action.
- * 2. The Hygiene field holds the updated hygiene value of the pet.
+ * 2. The Hygiene field holds the actual change of the pet's hygiene, after clamping.
  * 3. The Activity field holds the current activity of the pet.
  * 4. The Duration field holds the duration of the bath pet action.
  *
@@ -30,7 +30,7 @@ type BathPetMsg struct {
  */
 type BathPetMsgReply struct {
 	/**
-	 * Hygiene is the updated hygiene value of the pet.
+	 * Hygiene is the actual change of the pet's hygiene, negative when it decreased.
 	 */
 	Hygiene int `json:"hygiene"`
 	/**
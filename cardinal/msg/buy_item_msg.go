@@ -3,7 +3,7 @@ package msg
 
 /**
  * Function Flow:
- * 1. The ButItemMsg structure is created to hold the item name for the buy item action.
+ * 1. The ButItemMsg structure is created to hold the item name and quantity for the buy item action.
  * 2. The BuyItemMsgReply structure is created to hold the reply data for the buy item action.
  *
  * This package provides message structures for the buy item action.
@@ -13,12 +13,17 @@ type ButItemMsg struct {
 	 * Name is the name of the item to be bought.
 	 */
 	Name string `json:"name"`
+	/**
+	 * Quantity is the number of units to buy, 1 when omitted and at most `game.MaxStackQuantity`.
+	 */
+	Quantity int `json:"quantity,omitempty"`
 }
 
 /**
  * Function Flow:
  * 1. The BuyItemMsgReply structure is created to hold the reply data for the buy item action.
  * 2. The Success field holds the success status of the buy item action.
+ * 3. The Quantity field holds how many units of the item the player owns after the purchase.
  *
  * This structure provides the reply data for the buy item action.
  */
@@ -27,6 +32,10 @@ type BuyItemMsgReply struct {
 	 * Success is the success status of the buy item action.
 	 */
 	Success bool `json:"success"`
+	/**
+	 * Quantity is the number of units of the item the player owns after the purchase.
+	 */
+	Quantity int `json:"quantity"`
 }
 
 // buy_item_msg.go
@@ -0,0 +1,43 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import (
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The BuyListingMsg structure is created to hold the listing ID for the buy listing action.
+ * 2. The BuyListingMsgReply structure is created to hold the reply data for the buy listing action.
+ *
+ * This package provides message structures for the buy listing action.
+ */
+type BuyListingMsg struct {
+	/**
+	 * ListingID is the ID of the listing to be bought.
+	 */
+	ListingID types.EntityID `json:"listing_id"`
+}
+
+/**
+ * Function Flow:
+ * 1. The BuyListingMsgReply structure is created to hold the reply data for the buy listing action.
+ * 2. The Success field holds the success status of the buy listing action.
+ * 3. The Paid field holds the price paid by the buyer.
+ *
+ * This structure provides the reply data for the buy listing action.
+ */
+type BuyListingMsgReply struct {
+	/**
+	 * Success is the success status of the buy listing action.
+	 */
+	Success bool `json:"success"`
+	/**
+	 * Paid is the price paid by the buyer, in minor units.
+	 */
+	Paid game.Money `json:"paid"`
+}
+
+// buy_listing_msg.go
@@ -0,0 +1,63 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+/**
+ * Function Flow:
+ * 1. The CancelActivityMsg structure is created to hold the target nickname for the cancel activity action.
+ * 2. The CancelActivityMsgReply structure is created to hold the reply data for the cancel activity action.
+ *
+ * This package provides message structures for the cancel activity action.
+ */
+type CancelActivityMsg struct {
+	/**
+	 * TargetNickname is the nickname of the pet whose activity is stopped.
+	 */
+	TargetNickname string `json:"target"`
+}
+
+/**
+ * Function Flow:
+ * 1. The CancelActivityMsgReply structure is created to hold the reply data for the cancel activity action.
+ * 2. The Activity and Elapsed fields hold the activity that was stopped and how long it ran.
+ * 3. The Health, Energy, Hygiene, Wellness and Satiety fields hold the actual change of each stat,
+ *    from the prorated completion effects and the cancel penalty of the activity.
+ * 4. The XP field holds the prorated experience earned by the activity.
+ *
+ * This structure provides the reply data for the cancel activity action.
+ */
+type CancelActivityMsgReply struct {
+	/**
+	 * Activity is the activity that was stopped.
+	 */
+	Activity string `json:"activity"`
+	/**
+	 * Elapsed is the number of ticks the activity ran before it was stopped.
+	 */
+	Elapsed int `json:"elapsed"`
+	/**
+	 * Health is the actual change of the pet's health.
+	 */
+	Health int `json:"health"`
+	/**
+	 * Energy is the actual change of the pet's energy.
+	 */
+	Energy int `json:"energy"`
+	/**
+	 * Hygiene is the actual change of the pet's hygiene.
+	 */
+	Hygiene int `json:"hygiene"`
+	/**
+	 * Wellness is the actual change of the pet's wellness.
+	 */
+	Wellness int `json:"wellness"`
+	/**
+	 * Satiety is the actual change of the pet's satiety.
+	 */
+	Satiety int `json:"satiety"`
+	/**
+	 * XP is the experience earned by the activity, prorated to the ticks it ran.
+	 */
+	XP int64 `json:"xp"`
+}
+
+// cancel_activity_msg.go
@@ -0,0 +1,34 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+/**
+ * Function Flow:
+ * 1. The CancelTradeMsg structure is created to hold the trade ID for the cancel trade action.
+ * 2. The CancelTradeMsgReply structure is created to hold the reply data for the cancel trade action.
+ *
+ * This package provides message structures for the cancel trade action.
+ */
+type CancelTradeMsg struct {
+	/**
+	 * TradeID is the ID of the trade to be cancelled.
+	 */
+	TradeID types.EntityID `json:"trade_id"`
+}
+
+/**
+ * Function Flow:
+ * 1. The CancelTradeMsgReply structure is created to hold the reply data for the cancel trade action.
+ * 2. The Success field holds the success status of the cancel trade action.
+ *
+ * This structure provides the reply data for the cancel trade action.
+ */
+type CancelTradeMsgReply struct {
+	/**
+	 * Success is the success status of the cancel trade action.
+	 */
+	Success bool `json:"success"`
+}
+
+// cancel_trade_msg.go
@@ -0,0 +1,43 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import "pkg.world.dev/world-engine/cardinal/types"
+
+/**
+ * Function Flow:
+ * 1. The ChallengePetMsg structure is created to hold the pet and the opponent pet for the challenge pet action.
+ * 2. The ChallengePetMsgReply structure is created to hold the reply data for the challenge pet action.
+ *
+ * This package provides message structures for the challenge pet action.
+ */
+type ChallengePetMsg struct {
+	/**
+	 * PetNickname is the nickname of the challenger's pet.
+	 */
+	PetNickname string `json:"pet"`
+	/**
+	 * OpponentNickname is the nickname of the pet being challenged.
+	 */
+	OpponentNickname string `json:"opponent"`
+}
+
+/**
+ * Function Flow:
+ * 1. The ChallengePetMsgReply structure is created to hold the reply data for the challenge pet action.
+ * 2. The BattleID field holds the ID used to accept the challenge and read the battle log.
+ * 3. The ExpiresTick field holds the tick the challenge expires.
+ *
+ * This structure provides the reply data for the challenge pet action.
+ */
+type ChallengePetMsgReply struct {
+	/**
+	 * BattleID is the ID of the battle.
+	 */
+	BattleID types.EntityID `json:"battle_id"`
+	/**
+	 * ExpiresTick is the tick the challenge is dropped if it was not accepted.
+	 */
+	ExpiresTick uint64 `json:"expires_tick"`
+}
+
+// challenge_pet_msg.go
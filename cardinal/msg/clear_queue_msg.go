@@ -0,0 +1,32 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+/**
+ * Function Flow:
+ * 1. The ClearQueueMsg structure is created to hold the target nickname for the clear queue action.
+ * 2. The ClearQueueMsgReply structure is created to hold the reply data for the clear queue action.
+ *
+ * This package provides message structures for the clear queue action.
+ */
+type ClearQueueMsg struct {
+	/**
+	 * TargetNickname is the nickname of the pet whose queued actions are dropped.
+	 */
+	TargetNickname string `json:"target"`
+}
+
+/**
+ * Function Flow:
+ * 1. The ClearQueueMsgReply structure is created to hold the reply data for the clear queue action.
+ * 2. The Cleared field holds the number of actions dropped.
+ *
+ * This structure provides the reply data for the clear queue action.
+ */
+type ClearQueueMsgReply struct {
+	/**
+	 * Cleared is the number of queued actions dropped. The current activity of the pet goes on.
+	 */
+	Cleared int `json:"cleared"`
+}
+
+// clear_queue_msg.go
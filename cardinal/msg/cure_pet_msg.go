@@ -22,15 +22,25 @@ type CurePetMsg struct {
 /**
  * Function Flow:
  * 1. The CurePetMsgReply structure is created to hold the reply data for the cure pet action.
- * 2. The Health field holds the updated health value of the pet.
+ * 2. The Health field holds the actual change of the pet's health, after clamping.
+ * 3. The Cured field holds the disease the drug cured, if any.
+ * 4. The Prevents field holds the classes of diseases the pet is now immune to.
  *
  * This structure provides the reply data for the cure pet action.
  */
 type CurePetMsgReply struct {
 	/**
-	 * Health is the updated health value of the pet.
+	 * Health is the actual change of the pet's health, negative when it decreased.
 	 */
 	Health int `json:"health"`
+	/**
+	 * Cured is the disease the drug cured, empty if it cured none (see the `cures` of the item catalog).
+	 */
+	Cured string `json:"cured"`
+	/**
+	 * Prevents are the classes of diseases the pet is now immune to.
+	 */
+	Prevents []string `json:"prevents"`
 }
 
 // cure_pet_msg.go
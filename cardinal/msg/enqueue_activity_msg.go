@@ -0,0 +1,40 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+/**
+ * Function Flow:
+ * 1. The EnqueueActivityMsg structure is created to hold the target nickname, action and item for the enqueue activity action.
+ * 2. The EnqueueActivityMsgReply structure is created to hold the reply data for the enqueue activity action.
+ *
+ * This package provides message structures for the enqueue activity action.
+ */
+type EnqueueActivityMsg struct {
+	/**
+	 * TargetNickname is the nickname of the pet the action is queued on.
+	 */
+	TargetNickname string `json:"target"`
+	/**
+	 * Action is the action to queue: sleep, feed, bath or play.
+	 */
+	Action string `json:"action"`
+	/**
+	 * ItemName is the name of the item used by the action, empty for sleep. It is consumed when the action starts.
+	 */
+	ItemName string `json:"item_name"`
+}
+
+/**
+ * Function Flow:
+ * 1. The EnqueueActivityMsgReply structure is created to hold the reply data for the enqueue activity action.
+ * 2. The Position field holds the position of the action in the queue.
+ *
+ * This structure provides the reply data for the enqueue activity action.
+ */
+type EnqueueActivityMsgReply struct {
+	/**
+	 * Position is the position of the action in the pet's queue, 1 for the next action to start.
+	 */
+	Position int `json:"position"`
+}
+
+// enqueue_activity_msg.go
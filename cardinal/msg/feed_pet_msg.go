@@ -22,7 +22,7 @@ type FeedPetMsg struct {
 /**
  * Function Flow:
  * 1. The FeedPetMsgReply structure is created to hold the reply data for the feed pet action.
- * 2. The Health field holds the updated health value of the pet.
+ * 2. The Health, Energy and Satiety fields hold the updated values of the pet.
  * 3. The Activity field holds the current activity of the pet.
  * 4. The Duration field holds the duration of the feed pet action.
  *
@@ -33,6 +33,14 @@ type FeedPetMsgReply struct {
 	 * Health is the updated health value of the pet.
 	 */
 	Health int `json:"health"`
+	/**
+	 * Energy is the updated energy value of the pet.
+	 */
+	Energy int `json:"energy"`
+	/**
+	 * Satiety is the updated satiety value of the pet.
+	 */
+	Satiety int `json:"satiety"`
 	/**
 	 * Activity is the current activity of the pet.
 	 */
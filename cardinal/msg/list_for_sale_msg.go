@@ -0,0 +1,56 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import (
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The ListForSaleMsg structure is created to hold the goods and price for the list for sale action.
+ * 2. The ListForSaleMsgReply structure is created to hold the reply data for the list for sale action.
+ *
+ * This package provides message structures for the list for sale action.
+ * A listing sells either a stack of items (ItemName and Quantity) or a pet (PetName), never both.
+ */
+type ListForSaleMsg struct {
+	/**
+	 * ItemName is the name of the item to be listed.
+	 */
+	ItemName string `json:"item_name,omitempty"`
+	/**
+	 * Quantity is the number of units to list, 1 when omitted.
+	 */
+	Quantity int `json:"quantity,omitempty"`
+	/**
+	 * PetName is the nickname of the pet to be listed.
+	 */
+	PetName string `json:"pet_name,omitempty"`
+	/**
+	 * Price is the price of the whole listing, in minor units.
+	 */
+	Price game.Money `json:"price"`
+}
+
+/**
+ * Function Flow:
+ * 1. The ListForSaleMsgReply structure is created to hold the reply data for the list for sale action.
+ * 2. The ListingID field holds the ID buyers use to buy the listing.
+ * 3. The ExpiresTick field holds the tick the listing expires.
+ *
+ * This structure provides the reply data for the list for sale action.
+ */
+type ListForSaleMsgReply struct {
+	/**
+	 * ListingID is the ID of the listing.
+	 */
+	ListingID types.EntityID `json:"listing_id"`
+	/**
+	 * ExpiresTick is the tick the goods are returned to the seller if they were not bought.
+	 */
+	ExpiresTick uint64 `json:"expires_tick"`
+}
+
+// list_for_sale_msg.go
@@ -22,27 +22,32 @@ type PlayPetMsg struct {
 /**
  * Function Flow:
  * 1. The PlayPetMsgReply structure is created to hold the reply data for the play pet action.
- * 2. The Energy field holds the updated energy value of the pet.
- * 3. The Hygiene field holds the updated hygiene value of the pet.
- * 4. The Wellness field holds the updated wellness value of the pet.
- * 5. The Activity field holds the current activity of the pet.
- * 6. The Duration field holds the duration of the play pet action.
+ * 2. The Energy field holds the actual change of the pet's energy, after clamping.
+ * 3. The Hygiene field holds the actual change of the pet's hygiene, after clamping.
+ * 4. The Wellness field holds the actual change of the pet's wellness, after clamping.
+ * 5. The XP field holds the experience the pet earns when playing ends, scaled by its mood.
+ * 6. The Activity field holds the current activity of the pet.
+ * 7. The Duration field holds the duration of the play pet action.
  *
  * This structure provides the reply data for the play pet action.
  */
 type PlayPetMsgReply struct {
 	/**
-	 * Energy is the updated energy value of the pet.
+	 * Energy is the actual change of the pet's energy, negative when it decreased.
 	 */
 	Energy int `json:"energy"`
 	/**
-	 * Hygiene is the updated hygiene value of the pet.
+	 * Hygiene is the actual change of the pet's hygiene, negative when it decreased.
 	 */
 	Hygiene int `json:"hygiene"`
 	/**
-	 * Wellness is the updated wellness value of the pet.
+	 * Wellness is the actual change of the pet's wellness, negative when it decreased.
 	 */
 	Wellness int `json:"wellness"`
+	/**
+	 * XP is the experience the pet earns when playing ends, scaled by its mood.
+	 */
+	XP int64 `json:"xp"`
 	/**
 	 * Activity is the current activity of the pet.
 	 */
@@ -0,0 +1,89 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import (
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The TradeItem structure is created to hold an item name and quantity of a trade.
+ *
+ * This structure names a quantity of a store item.
+ */
+type TradeItem struct {
+	/**
+	 * Name is the name of the item.
+	 */
+	Name string `json:"name"`
+	/**
+	 * Quantity is the number of units of the item.
+	 */
+	Quantity int `json:"quantity"`
+}
+
+/**
+ * Function Flow:
+ * 1. The TradeAssets structure is created to hold the items, money and pets of one side of a trade.
+ *
+ * This structure describes one side of a trade.
+ */
+type TradeAssets struct {
+	/**
+	 * Items are the items to be traded.
+	 */
+	Items []TradeItem `json:"items"`
+	/**
+	 * Money is the money to be traded, in minor units.
+	 */
+	Money game.Money `json:"money"`
+	/**
+	 * Pets are the nicknames of the pets to be traded.
+	 */
+	Pets []string `json:"pets"`
+}
+
+/**
+ * Function Flow:
+ * 1. The ProposeTradeMsg structure is created to hold the recipient, offer and request for the propose trade action.
+ * 2. The ProposeTradeMsgReply structure is created to hold the reply data for the propose trade action.
+ *
+ * This package provides message structures for the propose trade action.
+ */
+type ProposeTradeMsg struct {
+	/**
+	 * Recipient is the persona tag of the player the trade is offered to.
+	 */
+	Recipient string `json:"recipient"`
+	/**
+	 * Offer is what the proposer gives, held in escrow until the trade settles.
+	 */
+	Offer TradeAssets `json:"offer"`
+	/**
+	 * Request is what the proposer asks from the recipient.
+	 */
+	Request TradeAssets `json:"request"`
+}
+
+/**
+ * Function Flow:
+ * 1. The ProposeTradeMsgReply structure is created to hold the reply data for the propose trade action.
+ * 2. The TradeID field holds the ID used to accept or cancel the trade.
+ * 3. The ExpiresTick field holds the tick the offer expires.
+ *
+ * This structure provides the reply data for the propose trade action.
+ */
+type ProposeTradeMsgReply struct {
+	/**
+	 * TradeID is the ID of the trade.
+	 */
+	TradeID types.EntityID `json:"trade_id"`
+	/**
+	 * ExpiresTick is the tick the offer is returned to the proposer if it was not accepted.
+	 */
+	ExpiresTick uint64 `json:"expires_tick"`
+}
+
+// propose_trade_msg.go
@@ -0,0 +1,36 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+/**
+ * Function Flow:
+ * 1. The RevivePetMsg structure is created to hold the target nickname and item name for the revive pet action.
+ * 2. The RevivePetMsgReply structure is created to hold the reply data for the revive pet action.
+ *
+ * This package provides message structures for the revive pet action.
+ */
+type RevivePetMsg struct {
+	/**
+	 * TargetNickname is the nickname of the deceased pet to be revived.
+	 */
+	TargetNickname string `json:"target"`
+	/**
+	 * ItemName is the name of the rare care item consumed by the revival.
+	 */
+	ItemName string `json:"item_name"`
+}
+
+/**
+ * Function Flow:
+ * 1. The RevivePetMsgReply structure is created to hold the reply data for the revive pet action.
+ * 2. The Health field holds the health value of the pet after revival.
+ *
+ * This structure provides the reply data for the revive pet action.
+ */
+type RevivePetMsgReply struct {
+	/**
+	 * Health is the health value of the pet after revival.
+	 */
+	Health int `json:"health"`
+}
+
+// revive_pet_msg.go
@@ -0,0 +1,48 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import "tamagotchi/game"
+
+/**
+ * Function Flow:
+ * 1. The SellItemMsg structure is created to hold the item name and quantity for the sell item action.
+ * 2. The SellItemMsgReply structure is created to hold the reply data for the sell item action.
+ *
+ * This package provides message structures for the sell item action.
+ */
+type SellItemMsg struct {
+	/**
+	 * Name is the name of the item to be sold.
+	 */
+	Name string `json:"name"`
+	/**
+	 * Quantity is the number of units to sell, 1 when omitted and at most `game.MaxStackQuantity`.
+	 */
+	Quantity int `json:"quantity,omitempty"`
+}
+
+/**
+ * Function Flow:
+ * 1. The SellItemMsgReply structure is created to hold the reply data for the sell item action.
+ * 2. The Success field holds the success status of the sell item action.
+ * 3. The Earned field holds the money paid by the store.
+ * 4. The Quantity field holds how many units of the item the player owns after the sale.
+ *
+ * This structure provides the reply data for the sell item action.
+ */
+type SellItemMsgReply struct {
+	/**
+	 * Success is the success status of the sell item action.
+	 */
+	Success bool `json:"success"`
+	/**
+	 * Earned is the money paid by the store, in minor units.
+	 */
+	Earned game.Money `json:"earned"`
+	/**
+	 * Quantity is the number of units of the item the player owns after the sale.
+	 */
+	Quantity int `json:"quantity"`
+}
+
+// sell_item_msg.go
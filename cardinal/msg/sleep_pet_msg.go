@@ -18,7 +18,7 @@ type SleepPetMsg struct {
 /**
  * Function Flow:
  * 1. The SleepPetMsgReply structure is created to hold the reply data for the sleep pet action.
- * 2. The Energy field holds the updated energy value of the pet.
+ * 2. The Energy field holds the energy of the pet as it falls asleep.
  * 3. The Activity field holds the current activity of the pet.
  * 4. The Duration field holds the duration of the sleep pet action.
  *
@@ -26,7 +26,7 @@ type SleepPetMsg struct {
  */
 type SleepPetMsgReply struct {
 	/**
-	 * Energy is the updated energy value of the pet.
+	 * Energy is the energy of the pet as it falls asleep, sleeping restores it over time.
 	 */
 	Energy int `json:"energy"`
 	/**
@@ -19,6 +19,9 @@ type ThinkPetMsg struct {
  * Function Flow:
  * 1. The ThinkPetMsgReply structure is created to hold the reply data for the think pet action.
  * 2. The Think field holds the thought of the pet.
+ * 3. The Need, Value and Explanation fields hold the most pressing need of the pet, empty when it needs nothing.
+ * 4. The Item and Store fields hold the store item recommended for the need.
+ * 5. The Wellness and NextBonusTick fields hold the wellness given by the attention and when it is given again.
  *
  * This structure provides the reply data for the think pet action.
  */
@@ -27,4 +30,34 @@ type ThinkPetMsgReply struct {
 	 * Think is the thought of the pet.
 	 */
 	Think string `json:"think"`
+	/**
+	 * Need is the stat the pet needs most, empty when it needs nothing.
+	 */
+	Need string `json:"need"`
+	/**
+	 * Value is the current value of the stat the pet needs most.
+	 */
+	Value int `json:"value"`
+	/**
+	 * Explanation tells the owner what the pet needs.
+	 */
+	Explanation string `json:"explanation"`
+	/**
+	 * Item is the store item raising the needed stat the most, empty when no item helps.
+	 */
+	Item string `json:"item"`
+	/**
+	 * Store is the store selling the item: foodstore, drugstore or toystore.
+	 */
+	Store string `json:"store"`
+	/**
+	 * Wellness is the wellness the pet got from the attention, 0 while the bonus is cooling down.
+	 */
+	Wellness int `json:"wellness"`
+	/**
+	 * NextBonusTick is the first tick asking the pet gives it wellness again.
+	 */
+	NextBonusTick uint64 `json:"next_bonus_tick"`
 }
+
+// think_pet_msg.go
@@ -0,0 +1,59 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+/**
+ * Function Flow:
+ * 1. The TrainSkillMsg structure is created to hold the target nickname for the train skill action.
+ * 2. The TrainSkillMsgReply structure is created to hold the reply data for the train skill action.
+ *
+ * This package provides message structures for the train skill action.
+ */
+type TrainSkillMsg struct {
+	/**
+	 * TargetNickname is the nickname of the pet to be trained.
+	 */
+	TargetNickname string `json:"target"`
+}
+
+/**
+ * Function Flow:
+ * 1. The TrainSkillMsgReply structure is created to hold the reply data for the train skill action.
+ * 2. The Kind, Level, XP and NextLevelXP fields hold the progress of the pet's skill, which earns its experience
+ *    when the training ends.
+ * 3. The Energy field holds the energy spent.
+ * 4. The Activity and Duration fields hold the activity started and its duration.
+ *
+ * This structure provides the reply data for the train skill action.
+ */
+type TrainSkillMsgReply struct {
+	/**
+	 * Kind is the kind of the pet's skill.
+	 */
+	Kind string `json:"kind"`
+	/**
+	 * Level is the level of the pet's skill.
+	 */
+	Level int64 `json:"lvl"`
+	/**
+	 * XP is the experience of the pet's skill towards the next level.
+	 */
+	XP int64 `json:"exp"`
+	/**
+	 * NextLevelXP is the experience required to reach the next level.
+	 */
+	NextLevelXP int64 `json:"next_lvl_exp"`
+	/**
+	 * Energy is the energy spent.
+	 */
+	Energy int `json:"energy"`
+	/**
+	 * Activity is the current activity of the pet.
+	 */
+	Activity string `json:"activity"`
+	/**
+	 * Duration is the duration of the activity.
+	 */
+	Duration int `json:"duration"`
+}
+
+// train_skill_msg.go
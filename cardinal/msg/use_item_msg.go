@@ -0,0 +1,68 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+/**
+ * Function Flow:
+ * 1. The UseItemMsg structure is created to hold the target nickname, item name and quantity for the use item action.
+ * 2. The UseItemMsgReply structure is created to hold the reply data for the use item action.
+ *
+ * This package provides message structures for the use item action.
+ */
+type UseItemMsg struct {
+	/**
+	 * TargetNickname is the nickname of the pet the item is used on.
+	 */
+	TargetNickname string `json:"target"`
+	/**
+	 * ItemName is the name of the item to use. Food is eaten, toys are played with and care items cure or bath the pet.
+	 */
+	ItemName string `json:"item_name"`
+	/**
+	 * Quantity is the number of units used at once, 1 when omitted and at most `game.MaxStackQuantity`.
+	 * The effects of every unit add up, while the action itself, e.g. the energy spent playing, happens once.
+	 */
+	Quantity int `json:"quantity,omitempty"`
+}
+
+/**
+ * Function Flow:
+ * 1. The UseItemMsgReply structure is created to hold the reply data for the use item action.
+ * 2. The Health, Energy, Hygiene, Wellness and Satiety fields hold the actual change of each stat, after clamping.
+ *    The effects of an item starting an activity are applied when the activity ends.
+ * 3. The Activity field holds the current activity of the pet.
+ * 4. The Duration field holds the duration of the activity.
+ *
+ * This structure provides the reply data for the use item action.
+ */
+type UseItemMsgReply struct {
+	/**
+	 * Health is the actual change of the pet's health.
+	 */
+	Health int `json:"health"`
+	/**
+	 * Energy is the actual change of the pet's energy.
+	 */
+	Energy int `json:"energy"`
+	/**
+	 * Hygiene is the actual change of the pet's hygiene.
+	 */
+	Hygiene int `json:"hygiene"`
+	/**
+	 * Wellness is the actual change of the pet's wellness.
+	 */
+	Wellness int `json:"wellness"`
+	/**
+	 * Satiety is the actual change of the pet's satiety.
+	 */
+	Satiety int `json:"satiety"`
+	/**
+	 * Activity is the current activity of the pet.
+	 */
+	Activity string `json:"activity"`
+	/**
+	 * Duration is the duration of the activity, 0 when the item is used instantly.
+	 */
+	Duration int `json:"duration"`
+}
+
+// use_item_msg.go
@@ -0,0 +1,55 @@
+// Package msg contains message structures for the Tamagotchi game.
+package msg
+
+import "tamagotchi/game"
+
+/**
+ * Function Flow:
+ * 1. The VacationModeMsg structure is created to hold the target nickname, mode and duration for the vacation mode action.
+ * 2. The VacationModeMsgReply structure is created to hold the reply data for the vacation mode action.
+ *
+ * This package provides message structures for the vacation mode action.
+ */
+type VacationModeMsg struct {
+	/**
+	 * TargetNickname is the nickname of the pet parked while its owner is away.
+	 */
+	TargetNickname string `json:"target"`
+	/**
+	 * Mode is how the pet is looked after: vacation freezes its stats, daycare slows their decline for a lower cost.
+	 */
+	Mode string `json:"mode"`
+	/**
+	 * Hours is how long the pet stays away before it returns automatically.
+	 */
+	Hours int `json:"hours"`
+}
+
+/**
+ * Function Flow:
+ * 1. The VacationModeMsgReply structure is created to hold the reply data for the vacation mode action.
+ * 2. The Activity, Duration and ReturnTick fields hold the activity parking the pet and when it returns.
+ * 3. The HourlyCost field holds the amount charged to the owner at the start of every hour.
+ *
+ * This structure provides the reply data for the vacation mode action.
+ */
+type VacationModeMsgReply struct {
+	/**
+	 * Activity is the activity parking the pet, Vacation or Daycare.
+	 */
+	Activity string `json:"activity"`
+	/**
+	 * Duration is the number of ticks the pet stays away.
+	 */
+	Duration int `json:"duration"`
+	/**
+	 * ReturnTick is the tick the pet returns at.
+	 */
+	ReturnTick uint64 `json:"return_tick"`
+	/**
+	 * HourlyCost is charged to the owner at the start of every hour, the first one included.
+	 */
+	HourlyCost game.Money `json:"hourly_cost"`
+}
+
+// vacation_mode_msg.go
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/query"
+)
+
+// TestPersonality_DerivedFromDna tests that a pet has the trait of each of its strong genes, and that its traits
+// change how fast its stats decline.
+func TestPersonality_DerivedFromDna(t *testing.T) {
+	// When:
+	// - The personalities of pets with strong and weak genes are derived.
+	strong := component.NewPersonality(component.Dna{A: 80, C: 10, G: game.TraitGeneThreshold, T: game.TraitGeneThreshold - 1})
+	ordinary := component.NewPersonality(component.Dna{A: 10, C: 20, G: 30, T: 40})
+
+	// Then:
+	// - Only the genes at or above the threshold give a trait.
+	assert.Equal(t, []string{game.TraitPlayful, game.TraitClean}, strong.Traits)
+	assert.True(t, strong.HasTrait(game.TraitClean))
+	assert.False(t, strong.HasTrait(game.TraitGlutton))
+	assert.Empty(t, ordinary.Traits)
+
+	// - The traits scale the decline of the stats they affect, and only those.
+	assert.Equal(t, 150, game.TraitDeclinePercent(strong.Traits, game.StatWellness))
+	assert.Equal(t, 50, game.TraitDeclinePercent(strong.Traits, game.StatHygiene))
+	assert.Equal(t, 100, game.TraitDeclinePercent(strong.Traits, game.StatSatiety))
+	assert.Equal(t, 100, game.TraitDeclinePercent(ordinary.Traits, game.StatWellness))
+}
+
+// TestSystem_PetFeedAction_GluttonEatsMore tests that a glutton gets more out of its food, and that its traits show
+// in its status.
+func TestSystem_PetFeedAction_GluttonEatsMore(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a hungry glutton are created, and the player buys food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	setPetPersonality(t, tf, petName, game.TraitGlutton)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: 10}))
+	assert.NoError(t, buyToy(t, tf, foodName))
+
+	// When:
+	// - The glutton is fed.
+	_, err = PetFeedAction(t, tf, petName, foodName)
+	assert.NoError(t, err)
+	endActivity(t, tf, petName)
+
+	// Then:
+	// - The satiety increased by half again the food's satiety once the meal ended.
+	care := game.TraitCarePercent([]string{game.TraitGlutton}, game.StatSatiety)
+	assert.Equal(t, 150, care)
+	hunger, err := component.GetPetHunger(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 10+catalogItem(t, foodName).Effect(game.StatSatiety)*care/100, hunger.Satiety)
+
+	// - The pet status shows the trait.
+	status, err := query.QueryPetStatus(wCtx, &query.PetStatusRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{game.TraitGlutton}, status.Status.Traits)
+}
+
+// TestThought_PersonalityRaisesUrgency tests that a pet's traits make it think about what matters to it first.
+func TestThought_PersonalityRaisesUrgency(t *testing.T) {
+	// Given:
+	// - A pet that is both starving and dirty.
+	state := game.ThoughtState{Stats: map[string]int{
+		game.StatHealth:   100,
+		game.StatEnergy:   100,
+		game.StatHygiene:  30,
+		game.StatWellness: 100,
+		game.StatSatiety:  game.StarvationThreshold,
+	}, Hour: 12}
+
+	// When / Then:
+	// - An ordinary pet thinks about food first.
+	rule, ok := game.UrgentThought(state)
+	assert.True(t, ok)
+	assert.Equal(t, game.StatSatiety, rule.Need)
+
+	// - A clean pet thinks about its bath first, in its own words.
+	state.Traits = []string{game.TraitClean, game.StageAdult}
+	rule, ok = game.UrgentThought(state)
+	assert.True(t, ok)
+	assert.Equal(t, game.StatHygiene, rule.Need)
+	assert.Greater(t, rule.Urgency, 90)
+	assert.Equal(t, rule.Traits[game.TraitClean], rule.TextsFor(state.Traits))
+}
+
+// TestSystem_PetBreedAction_InheritsPersonality tests that a bred pet gets the personality of its inherited Dna.
+func TestSystem_PetBreedAction_InheritsPersonality(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - Two adult pets with strong genes are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, motherName, personaTag))
+	assert.NoError(t, createPet(t, tf, fatherName, personaTag))
+	for _, name := range []string{motherName, fatherName} {
+		setPetLifeStage(t, tf, name, game.StageAdult)
+		rwCtx := cardinal.NewWorldContext(tf.World)
+		petId, _, err := component.GetPetByNickname(rwCtx, name)
+		assert.NoError(t, err)
+		assert.NoError(t, cardinal.SetComponent(rwCtx, petId, &component.Dna{A: 95, C: 5, G: 5, T: 95}))
+	}
+
+	// When:
+	// - The pets are bred.
+	assert.NoError(t, PetBreedAction(t, tf, motherName, fatherName, childName))
+
+	// Then:
+	// - The child's personality is derived from its Dna, inheriting the strong genes of its parents.
+	childId, _, err := component.GetPetByNickname(wCtx, childName)
+	assert.NoError(t, err)
+	childDna, err := cardinal.GetComponent[component.Dna](wCtx, childId)
+	assert.NoError(t, err)
+	personality, err := cardinal.GetComponent[component.Personality](wCtx, childId)
+	assert.NoError(t, err)
+	assert.Equal(t, component.NewPersonality(*childDna).Traits, personality.Traits)
+	assert.True(t, personality.HasTrait(game.TraitPlayful))
+	assert.True(t, personality.HasTrait(game.TraitGlutton))
+	assert.False(t, personality.HasTrait(game.TraitLazy))
+}
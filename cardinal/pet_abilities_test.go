@@ -0,0 +1,159 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+// TestSystem_PetTrainSkillAction tests that training starts an activity, spends energy and grants skill XP when it ends.
+func TestSystem_PetTrainSkillAction(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+
+	// - The new pet has level 0 abilities.
+	abilities, err := query.QueryPetAbilities(wCtx, &query.PetAbilitiesRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.NotNil(t, abilities.Magic)
+	assert.NotNil(t, abilities.Skill)
+	assert.Contains(t, game.Skills, abilities.Skill.Kind)
+	assert.Zero(t, abilities.Skill.Level)
+	assert.Equal(t, component.CalculateNextLevelXP(0, 100, 1.1), abilities.Skill.NextLevelXP)
+
+	// When:
+	// - The pet trains twice in a row.
+	reply, err := executeTx[msg.TrainSkillMsgReply](t, tf, trainSkillMsgName, msg.TrainSkillMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+	_, busyErr := executeTx[msg.TrainSkillMsgReply](t, tf, trainSkillMsgName, msg.TrainSkillMsg{TargetNickname: petName}, personaTag)
+
+	// Then:
+	// - The first training started the activity, and its XP waits for the training to end.
+	assert.Zero(t, reply.XP)
+	assert.Equal(t, "Training", reply.Activity)
+	assert.Equal(t, game.AbilityTrainingTicks, reply.Duration)
+
+	// - The pet spent energy and was busy for the second training.
+	assert.Error(t, busyErr)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	energy, err := component.GetPetEnergy(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.MaxEnergy-game.SkillTrainEnergy, energy.E)
+
+	// - The skill earned its XP once the training ended.
+	endActivity(t, tf, petName)
+	abilities, err = query.QueryPetAbilities(wCtx, &query.PetAbilitiesRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(game.SkillTrainXP), abilities.Skill.XP)
+	assert.Equal(t, 25, abilities.Skill.Percentage)
+}
+
+// TestSystem_PetPracticeMagicAction_LevelUp tests that magic levels up on the pet XP curve.
+func TestSystem_PetPracticeMagicAction_LevelUp(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and pet are created, and the pet's magic is close to the next level.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	magic, err := cardinal.GetComponent[component.Magic](wCtx, petId)
+	assert.NoError(t, err)
+	magic.XP = magic.NextLevelXP - 10
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, magic))
+
+	// When:
+	// - The pet practices magic until the practice ends.
+	reply, err := executeTx[msg.PracticeMagicMsgReply](t, tf, practiceMagicMsgName, msg.PracticeMagicMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, magic.Kind, reply.Kind)
+	assert.Equal(t, "Practicing", reply.Activity)
+	endActivity(t, tf, petName)
+
+	// Then:
+	// - The magic reached level 1 and carries the extra XP over.
+	magic, err = cardinal.GetComponent[component.Magic](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), magic.Level)
+	assert.Equal(t, int64(game.MagicPracticeXP-10), magic.XP)
+	assert.Equal(t, component.CalculateNextLevelXP(1, 100, 1.1), magic.NextLevelXP)
+}
+
+// TestSystem_CancelActivityAction_ProratesXP tests that training or playing stopped halfway only earns half of the
+// experience, so that starting and stopping them over and over earns no more than letting them run.
+func TestSystem_CancelActivityAction_ProratesXP(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and pet far from its next level are created, and the player buys a toy.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	assert.NoError(t, buyToy(t, tf, playToyName))
+	petId, pet, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	pet.NextLevelXP = 1000
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, pet))
+	halfway := func() {
+		activity, err := component.GetPetActivity(wCtx, petId)
+		assert.NoError(t, err)
+		activity.CountDown = activity.TotalTicks / 2
+		assert.NoError(t, cardinal.SetComponent(wCtx, petId, activity))
+	}
+	cancel := func() *msg.CancelActivityMsgReply {
+		reply, err := executeTx[msg.CancelActivityMsgReply](t, tf, cancelActivityMsgName,
+			msg.CancelActivityMsg{TargetNickname: petName}, personaTag)
+		assert.NoError(t, err)
+		return reply
+	}
+
+	// When:
+	// - The pet trains twice, stopping halfway each time.
+	for i := 0; i < 2; i++ {
+		_, err = executeTx[msg.TrainSkillMsgReply](t, tf, trainSkillMsgName, msg.TrainSkillMsg{TargetNickname: petName}, personaTag)
+		assert.NoError(t, err)
+		halfway()
+		assert.Equal(t, int64(game.SkillTrainXP/2), cancel().XP)
+	}
+
+	// - The pet plays, stopping halfway.
+	play, err := executeTx[msg.PlayPetMsgReply](t, tf, playMsgName,
+		msg.PlayPetMsg{TargetNickname: petName, ItemName: playToyName}, personaTag)
+	assert.NoError(t, err)
+	halfway()
+	played := cancel()
+
+	// Then:
+	// - Two half trainings earned the XP of one full training.
+	skill, err := component.GetPetSkill(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2*(game.SkillTrainXP/2)), skill.XP)
+
+	// - Playing earned half of its XP.
+	assert.Equal(t, play.XP/2, played.XP)
+	pet, err = cardinal.GetComponent[component.Pet](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, played.XP, pet.XP)
+}
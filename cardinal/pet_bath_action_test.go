@@ -53,10 +53,6 @@ func TestSystem_PetBathAction_HappyPath(t *testing.T) {
 
 	// Then:
 	// - The pet's components are updated correctly.
-	petHygiene, err = cardinal.GetComponent[component.Hygiene](wCtx, petId)
-	assert.NoError(t, err)
-	assert.Greater(t, petHygiene.Hy, 0)
-
 	petEnergy, err := cardinal.GetComponent[component.Energy](wCtx, petId)
 	assert.NoError(t, err)
 	assert.Equal(t, 90, petEnergy.E)
@@ -72,6 +68,12 @@ func TestSystem_PetBathAction_HappyPath(t *testing.T) {
 	petThink, err := cardinal.GetComponent[component.Think](wCtx, petId)
 	assert.NoError(t, err)
 	assert.Equal(t, petThink.Think, game.InitialThink)
+
+	// - The pet is clean once the bath ended.
+	endActivity(t, tf, petName)
+	petHygiene, err = cardinal.GetComponent[component.Hygiene](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Greater(t, petHygiene.Hy, 0)
 }
 
 // TestSystem_PetBathAction_NoPet tests that an error is returned when the pet does not exist.
@@ -225,7 +227,8 @@ func TestSystem_PetBathAction_MaxHygiene(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Then:
-	// - The pet's hygiene is set to the maximum value.
+	// - The pet's hygiene is set to the maximum value once the bath ended.
+	endActivity(t, tf, petName)
 	petHygiene, err = cardinal.GetComponent[component.Hygiene](wCtx, petId)
 	assert.NoError(t, err)
 	assert.Equal(t, 30, petHygiene.Hy)
@@ -270,7 +273,8 @@ func TestSystem_PetBathAction_MinHygiene(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Then:
-	// - The pet's hygiene is increased.
+	// - The pet's hygiene is increased once the bath ended.
+	endActivity(t, tf, petName)
 	petHygiene, err = cardinal.GetComponent[component.Hygiene](wCtx, petId)
 	assert.NoError(t, err)
 	assert.Greater(t, petHygiene.Hy, 0)
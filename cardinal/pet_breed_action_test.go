@@ -0,0 +1,167 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/query"
+)
+
+const (
+	motherName = "Mother"
+	fatherName = "Father"
+	childName  = "Child"
+)
+
+// TestSystem_PetBreedAction_HappyPath tests that a bred pet inherits its Dna from both parents.
+func TestSystem_PetBreedAction_HappyPath(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona and player are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+
+	// - Two adult pets are created that belong to the player.
+	assert.NoError(t, createPet(t, tf, motherName, personaTag))
+	assert.NoError(t, createPet(t, tf, fatherName, personaTag))
+	setPetLifeStage(t, tf, motherName, game.StageAdult)
+	setPetLifeStage(t, tf, fatherName, game.StageAdult)
+
+	// When:
+	// - The pets are bred.
+	err := PetBreedAction(t, tf, motherName, fatherName, childName)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The child exists and belongs to the player.
+	motherId, _, err := component.GetPetByNickname(wCtx, motherName)
+	assert.NoError(t, err)
+	fatherId, _, err := component.GetPetByNickname(wCtx, fatherName)
+	assert.NoError(t, err)
+	childId, _, err := component.GetPetByNickname(wCtx, childName)
+	assert.NoError(t, err)
+
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.True(t, player.HasPet(childId))
+
+	// - The child is born as an egg.
+	stage, err := component.GetPetLifeStage(wCtx, childId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.StageEgg, stage.Stage)
+
+	// - Every gene of the child is derived from its parents.
+	motherDna, err := cardinal.GetComponent[component.Dna](wCtx, motherId)
+	assert.NoError(t, err)
+	fatherDna, err := cardinal.GetComponent[component.Dna](wCtx, fatherId)
+	assert.NoError(t, err)
+	childDna, err := cardinal.GetComponent[component.Dna](wCtx, childId)
+	assert.NoError(t, err)
+
+	lineage, ok := component.GetPetLineage(wCtx, childId)
+	assert.True(t, ok)
+	assert.Equal(t, motherId, lineage.MotherID)
+	assert.Equal(t, fatherId, lineage.FatherID)
+	assert.Len(t, lineage.Genes, len(component.DnaGenes))
+
+	for i, gene := range childDna.Genes() {
+		low := min(motherDna.Genes()[i], fatherDna.Genes()[i]) - game.DnaMutationRange
+		high := max(motherDna.Genes()[i], fatherDna.Genes()[i]) + game.DnaMutationRange
+		assert.GreaterOrEqual(t, gene, low)
+		assert.LessOrEqual(t, gene, high)
+		assert.Equal(t, 100, lineage.Genes[i].Father+lineage.Genes[i].Mother)
+		assert.Equal(t, gene, lineage.Genes[i].Value)
+	}
+}
+
+// TestSystem_PetBreedAction_LineageQuery tests that the lineage query returns the parents of a bred pet.
+func TestSystem_PetBreedAction_LineageQuery(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and two adult pets are created, and the pets are bred.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, motherName, personaTag))
+	assert.NoError(t, createPet(t, tf, fatherName, personaTag))
+	setPetLifeStage(t, tf, motherName, game.StageAdult)
+	setPetLifeStage(t, tf, fatherName, game.StageAdult)
+	assert.NoError(t, PetBreedAction(t, tf, motherName, fatherName, childName))
+
+	// When:
+	// - The lineage of the child is queried.
+	response, err := query.QueryPetLineage(wCtx, &query.PetLineageRequest{Nickname: childName})
+	assert.NoError(t, err)
+
+	// Then:
+	// - The parents are founders and the child is not.
+	assert.False(t, response.Pet.Founder)
+	assert.Equal(t, motherName, response.Mother.Nickname)
+	assert.Equal(t, fatherName, response.Father.Nickname)
+	assert.True(t, response.Mother.Founder)
+	assert.True(t, response.Father.Founder)
+	assert.Empty(t, response.Grandparents)
+	assert.Len(t, response.Genes, len(component.DnaGenes))
+}
+
+// TestSystem_PetBreedAction_DuplicateBornName tests that a child cannot take the name of an existing pet.
+func TestSystem_PetBreedAction_DuplicateBornName(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and two pets are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, motherName, personaTag))
+	assert.NoError(t, createPet(t, tf, fatherName, personaTag))
+
+	// When:
+	// - The pets are bred with the name of the mother.
+	err := PetBreedAction(t, tf, motherName, fatherName, motherName)
+
+	// Then:
+	// - The breed is rejected.
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "Name already exist")
+}
+
+// TestSystem_PetBreedAction_TooYoung tests that pets cannot breed before adulthood.
+func TestSystem_PetBreedAction_TooYoung(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player, an adult father and a teen mother are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, motherName, personaTag))
+	assert.NoError(t, createPet(t, tf, fatherName, personaTag))
+	setPetLifeStage(t, tf, motherName, game.StageTeen)
+	setPetLifeStage(t, tf, fatherName, game.StageAdult)
+
+	// When:
+	// - The pets are bred.
+	err := PetBreedAction(t, tf, motherName, fatherName, childName)
+
+	// Then:
+	// - The breed is rejected and no child is created.
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "only breed as adults")
+	found, _, err := component.QueryPetIdByName(cardinal.NewReadOnlyWorldContext(tf.World), childName)
+	assert.NoError(t, err)
+	assert.False(t, found)
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+const reviveItemName = "Phoenix Feather"
+
+// killPetByHygiene leaves an adult pet dirty with 1 HP and ticks until the health decline kills it.
+func killPetByHygiene(t *testing.T, tf *cardinal.TestFixture, nickName string) {
+	setPetLifeStage(t, tf, nickName, game.StageAdult)
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, nickName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Health{HP: 1}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hygiene{Hy: 0}))
+	for i := 0; i < game.DeclineTickRate; i++ {
+		tf.DoTick()
+	}
+}
+
+// TestSystem_HealthDeclineSystem_PetDies tests that a pet dies and is moved to the graveyard when its health reaches zero.
+func TestSystem_HealthDeclineSystem_PetDies(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+
+	// When:
+	// - The pet is left dirty until its health reaches zero.
+	killPetByHygiene(t, tf, petName)
+
+	// Then:
+	// - The pet is deceased because of its hygiene.
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	deceased, ok := component.GetPetDeceased(wCtx, petId)
+	assert.True(t, ok)
+	assert.Equal(t, game.DeathHygiene, deceased.Cause)
+
+	// - The pet moved from the player's pets to the graveyard.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.False(t, player.HasPet(petId))
+	graveyard, err := query.QueryGraveyard(wCtx, &query.GraveyardMsg{PersonaTag: personaTag})
+	assert.NoError(t, err)
+	assert.Len(t, graveyard.Graves, 1)
+	assert.Equal(t, petName, graveyard.Graves[0].Nickname)
+	assert.Equal(t, game.DeathHygiene, graveyard.Graves[0].Cause)
+
+	// - The pet no longer accepts actions.
+	_, err = executeTx[msg.SleepPetMsgReply](t, tf, sleepMsgName, msg.SleepPetMsg{TargetNickname: petName}, personaTag)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "pet is deceased")
+}
+
+// TestSystem_PetReviveAction_HappyPath tests that a deceased pet can be revived with a revive item.
+func TestSystem_PetReviveAction_HappyPath(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and a deceased pet are created, and the player buys a revive item.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	killPetByHygiene(t, tf, petName)
+	assert.NoError(t, buyToy(t, tf, reviveItemName))
+
+	// When:
+	// - The pet is revived.
+	reply, err := executeTx[msg.RevivePetMsgReply](t, tf, reviveMsgName,
+		msg.RevivePetMsg{TargetNickname: petName, ItemName: reviveItemName}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet is alive, back with the player and the item was consumed.
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.False(t, component.IsPetDeceased(wCtx, petId))
+	assert.Equal(t, catalogItem(t, reviveItemName).Effect(game.EffectRevive), reply.Health)
+
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.True(t, player.HasPet(petId))
+	assert.Empty(t, player.Graveyard)
+	_, err = player.GetItemByName(wCtx, reviveItemName)
+	assert.Error(t, err)
+}
+
+// TestSystem_PetReviveAction_NotDeceased tests that a living pet cannot be revived.
+func TestSystem_PetReviveAction_NotDeceased(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and pet are created, and the player buys a revive item.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	assert.NoError(t, buyToy(t, tf, reviveItemName))
+
+	// When:
+	// - The living pet is revived.
+	_, err := executeTx[msg.RevivePetMsgReply](t, tf, reviveMsgName,
+		msg.RevivePetMsg{TargetNickname: petName, ItemName: reviveItemName}, personaTag)
+
+	// Then:
+	// - The revival is rejected.
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is alive")
+}
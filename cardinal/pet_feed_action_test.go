@@ -0,0 +1,97 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+const foodName = "Soup"
+
+// This function feeds a pet.
+// Flow:
+// 1. Build the feed message for the pet and food.
+// 2. Execute the transaction and return its reply.
+func PetFeedAction(t *testing.T, tf *cardinal.TestFixture, nickName string, itemName string) (*msg.FeedPetMsgReply, error) {
+	feedMsg := msg.FeedPetMsg{
+		TargetNickname: nickName,
+		ItemName:       itemName,
+	}
+	return executeTx[msg.FeedPetMsgReply](t, tf, eatMsgName, feedMsg, personaTag)
+}
+
+// TestSystem_PetFeedAction_RestoresSatiety tests that feeding a hungry pet restores its satiety and consumes the food.
+func TestSystem_PetFeedAction_RestoresSatiety(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and a hungry pet are created, and the player buys food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: 10}))
+	assert.NoError(t, buyToy(t, tf, foodName))
+
+	// When:
+	// - The pet is fed.
+	reply, err := PetFeedAction(t, tf, petName, foodName)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet is eating, and its satiety only rises when the meal ends.
+	assert.Equal(t, 10, reply.Satiety)
+	assert.Equal(t, "Eating", reply.Activity)
+
+	// - The satiety increased by the food's satiety once the meal ended.
+	endActivity(t, tf, petName)
+	hunger, err := component.GetPetHunger(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 10+catalogItem(t, foodName).Effect(game.StatSatiety), hunger.Satiety)
+
+	// - The food was consumed.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	_, err = player.GetItemByName(wCtx, foodName)
+	assert.Error(t, err)
+}
+
+// TestSystem_HealthDeclineSystem_Starvation tests that a starving pet loses health and dies of starvation.
+func TestSystem_HealthDeclineSystem_Starvation(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and an adult, clean but starving pet with 1 HP are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	setPetLifeStage(t, tf, petName, game.StageAdult)
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: 0}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Health{HP: 1}))
+
+	// When:
+	// - A decline cycle passes.
+	for i := 0; i < game.DeclineTickRate; i++ {
+		tf.DoTick()
+	}
+
+	// Then:
+	// - The pet died of starvation.
+	deceased, ok := component.GetPetDeceased(wCtx, petId)
+	assert.True(t, ok)
+	assert.Equal(t, game.DeathStarvation, deceased.Cause)
+}
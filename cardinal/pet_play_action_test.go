@@ -251,7 +251,13 @@ func TestSystem_PetPlayAction_PetExperienceAndLevelUpdate(t *testing.T) {
 	assert.NoError(t, err)
 
 	// Then:
-	// - The pet's experience and level are updated correctly.
+	// - The pet's experience waits for playing to end.
+	_, pet, err = component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.Zero(t, pet.XP)
+
+	// - The pet's experience and level are updated correctly once playing ended.
+	endActivity(t, tf, petName)
 	_, pet, err = component.GetPetByNickname(wCtx, petName)
 	assert.NoError(t, err)
 	assert.Greater(t, pet.XP, int64(0))
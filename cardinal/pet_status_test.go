@@ -0,0 +1,83 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/query"
+)
+
+// TestQuery_PetStatus tests that the pet status query returns every component of a pet in one response.
+func TestQuery_PetStatus(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a pet with a low hygiene are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hygiene{Hy: 42}))
+
+	// When:
+	// - The status of the pet is queried.
+	response, err := query.QueryPetStatus(wCtx, &query.PetStatusRequest{Nickname: petName})
+	assert.NoError(t, err)
+
+	// Then:
+	// - The response holds the pet and its components.
+	status := response.Status
+	assert.Equal(t, petId, status.ID)
+	assert.Equal(t, petName, status.Pet.Nickname)
+	assert.Equal(t, personaTag, status.Pet.PersonaTag)
+	assert.Equal(t, 42, status.Hygiene)
+	assert.Equal(t, game.MaxWellness, status.Wellness)
+	assert.Equal(t, game.StageEgg, status.Stage)
+	assert.NotNil(t, status.Activity)
+	assert.NotNil(t, status.Dna)
+	assert.NotNil(t, status.Magic)
+	assert.NotNil(t, status.Skill)
+
+	// - The pet is a living founder.
+	assert.Nil(t, status.Lineage)
+	assert.Nil(t, status.Deceased)
+
+	// - Unknown pets are reported.
+	_, err = query.QueryPetStatus(wCtx, &query.PetStatusRequest{Nickname: "Nobody"})
+	assert.Error(t, err)
+}
+
+// TestQuery_PlayerPets tests that the player pets query returns the status of every pet of a player.
+func TestQuery_PlayerPets(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and two pets are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, motherName, personaTag))
+	assert.NoError(t, createPet(t, tf, fatherName, personaTag))
+
+	// When:
+	// - The pets of the player are queried.
+	response, err := query.QueryPlayerPets(wCtx, &query.PlayerPetsRequest{PersonaTag: personaTag})
+	assert.NoError(t, err)
+
+	// Then:
+	// - Both pets are returned in order.
+	assert.Len(t, response.Pets, 2)
+	assert.Equal(t, motherName, response.Pets[0].Pet.Nickname)
+	assert.Equal(t, fatherName, response.Pets[1].Pet.Nickname)
+	assert.Equal(t, game.MaxHP, response.Pets[0].Health)
+}
@@ -8,6 +8,7 @@ import (
 	"pkg.world.dev/world-engine/cardinal"
 
 	"tamagotchi/component"
+	"tamagotchi/game"
 	"tamagotchi/query"
 )
 
@@ -365,3 +366,34 @@ func TestSystem_PetSpawnerSystem_Concurrency_CreateMultiplePetsConcurrentlyWithN
 	assert.NoError(t, err)
 	assert.Empty(t, response.Pets, "Expected no pets to be created, but got %v", len(response.Pets))
 }
+
+// Test: Life Stage - A new pet hatches from an egg into a baby
+func TestSystem_LifeStageSystem_EggHatches(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+
+	// - The new pet is an egg.
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+	health, err := query.QueryPetHealth(wCtx, &query.PetHealthRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Equal(t, game.StageEgg, health.Stage)
+
+	// When:
+	// - Enough ticks pass for the egg to hatch.
+	for i := uint64(0); i < game.LifeStages[1].MinAge+game.LifeStageTickRate; i++ {
+		tf.DoTick()
+	}
+
+	// Then:
+	// - The pet is a baby.
+	energy, err := query.QueryPetEnergy(wCtx, &query.PetEnergyRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Equal(t, game.StageBaby, energy.Stage)
+}
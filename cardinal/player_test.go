@@ -1,7 +1,9 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 	"sync"
 	"testing"
@@ -10,6 +12,9 @@ import (
 	"pkg.world.dev/world-engine/cardinal"
 
 	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
 )
 
 func TestSuccessfulPlayerCreation(t *testing.T) {
@@ -95,6 +100,7 @@ func TestCreatePlayerWithEmptyPetsAndItems(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, player.Pets)
 	assert.Empty(t, player.Items)
+	assert.Empty(t, player.Inventory)
 }
 
 func TestCreatePlayerWithInitialMoney(t *testing.T) {
@@ -116,7 +122,37 @@ func TestCreatePlayerWithInitialMoney(t *testing.T) {
 	player, err := cardinal.GetComponent[component.Player](wCtx, playerID)
 	assert.NoError(t, err)
 	assert.NotNil(t, player.Money)
-	assert.Equal(t, float64(1000), player.Money) // assuming the initial money is 1000
+	assert.Equal(t, game.PlayerInitialMoney, player.Money)
+}
+
+func TestPlayerAndItemReadLegacyCoins(t *testing.T) {
+	// Given: A player and an item saved before amounts were in minor units, with floating point coins.
+	var player component.Player
+	var item component.Item
+
+	// When: They are read.
+	assert.NoError(t, json.Unmarshal([]byte(`{"personaTag":"legacy","money":12.5}`), &player))
+	assert.NoError(t, json.Unmarshal([]byte(`{"name":"Apple","price":0.0004}`), &item))
+
+	// Then: The coins are converted to minor units, under the original JSON names.
+	assert.Equal(t, "legacy", player.PersonaTag)
+	assert.Equal(t, 12*game.Coin+game.Coin/2, player.Money)
+	assert.Equal(t, game.Money(4), item.Price)
+
+	// And: Saved again, the amounts are kept in minor units.
+	data, err := json.Marshal(player)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"money":125000`)
+	var saved component.Player
+	assert.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, player.Money, saved.Money)
+
+	data, err = json.Marshal(item)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"price":4`)
+	var savedItem component.Item
+	assert.NoError(t, json.Unmarshal(data, &savedItem))
+	assert.Equal(t, item, savedItem)
 }
 
 func TestCreatePlayerWithInvalidPersonaTagLength(t *testing.T) {
@@ -261,3 +297,59 @@ func TestTransactionsWithDifferentPersonaTags(t *testing.T) {
 		assert.NotNil(t, playerID)
 	}
 }
+
+func TestPlayerLedgerRecordsMoneyMovements(t *testing.T) {
+	// Given: A player that creates a pet, buys food and feeds the pet.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	_, err := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: foodName, Quantity: 2}, personaTag)
+	assert.NoError(t, err)
+	_, err = PetFeedAction(t, tf, petName, foodName)
+	assert.NoError(t, err)
+
+	// When: The pet eats for a few ticks, which earns nothing yet.
+	for i := 0; i < 3; i++ {
+		tf.DoTick()
+	}
+	ledger, err := query.QueryPlayerLedger(wCtx, &query.PlayerLedgerRequest{PersonaTag: personaTag})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, ledger.Total)
+
+	// And: The meal ends and the ledger is queried.
+	endActivity(t, tf, petName)
+	ledger, err = query.QueryPlayerLedger(wCtx, &query.PlayerLedgerRequest{PersonaTag: personaTag})
+	assert.NoError(t, err)
+
+	// Then: The pet creation, the purchase and a single activity income entry are recorded, newest first.
+	assert.Equal(t, 3, ledger.Total)
+	assert.Equal(t, game.LedgerActivityIncome, ledger.Entries[0].Reason)
+	assert.Equal(t, petName, ledger.Entries[0].Reference)
+	assert.Greater(t, ledger.Entries[0].Amount, game.PetEarnMoney)
+	assert.Equal(t, game.LedgerPurchase, ledger.Entries[1].Reason)
+	assert.Equal(t, -2*catalogItem(t, foodName).Price, ledger.Entries[1].Amount)
+	assert.Equal(t, game.LedgerPetCreation, ledger.Entries[2].Reason)
+	assert.Equal(t, -game.PetCost, ledger.Entries[2].Amount)
+
+	// And: The entries add up to the balance.
+	total := game.PlayerInitialMoney
+	for _, entry := range ledger.Entries {
+		total += entry.Amount
+	}
+	assert.Equal(t, ledger.Balance, total)
+
+	// And: Pages split the history.
+	page, err := query.QueryPlayerLedger(wCtx, &query.PlayerLedgerRequest{PersonaTag: personaTag, Page: 1, PageSize: 2})
+	assert.NoError(t, err)
+	assert.Len(t, page.Entries, 1)
+	assert.Equal(t, game.LedgerPetCreation, page.Entries[0].Reason)
+
+	// And: A page past the end is empty, however far.
+	page, err = query.QueryPlayerLedger(wCtx, &query.PlayerLedgerRequest{PersonaTag: personaTag, Page: math.MaxInt})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, page.Total)
+	assert.Empty(t, page.Entries)
+}
@@ -0,0 +1,87 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"tamagotchi/component"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+// Flow:
+// 1. Retrieve the battle with the given ID.
+// 2. Resolve the nicknames of the pets.
+// 3. Return the status, outcome and log of the battle.
+type BattleLogRequest struct {
+	// The ID of the battle, as returned by the challenge.
+	BattleID types.EntityID `json:"battle_id"`
+}
+
+// BattleLogEntry describes one attack of a battle.
+type BattleLogEntry struct {
+	Round      int    `json:"round"`
+	Tick       uint64 `json:"tick"`
+	Attacker   string `json:"attacker"`
+	Defender   string `json:"defender"`
+	Damage     int    `json:"damage"`
+	DefenderHP int    `json:"defender_hp"`
+}
+
+// BattleLogResponse represents the response to a battle log query.
+type BattleLogResponse struct {
+	Status       string `json:"status"`
+	Challenger   string `json:"challenger"`
+	Opponent     string `json:"opponent"`
+	ChallengerHP int    `json:"challenger_hp"`
+	OpponentHP   int    `json:"opponent_hp"`
+	// Winner is the nickname of the winning pet, empty until the battle finishes or for a draw.
+	Winner string           `json:"winner"`
+	Log    []BattleLogEntry `json:"log"`
+}
+
+/**
+ * QueryBattleLog queries the log of a battle.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the battle log, or an error if the query fails.
+ */
+func QueryBattleLog(world cardinal.WorldContext, req *BattleLogRequest) (*BattleLogResponse, error) {
+	// Step 1: Retrieve the battle.
+	battle, err := component.GetBattle(world, req.BattleID)
+	if err != nil {
+		return nil, err
+	}
+
+	log := component.GetBattleLog(world, req.BattleID)
+
+	// Step 2: Resolve the nicknames of the pets.
+	nicknames := map[types.EntityID]string{}
+	for _, petID := range []types.EntityID{battle.ChallengerPet, battle.OpponentPet} {
+		if pet, err := cardinal.GetComponent[component.Pet](world, petID); err == nil {
+			nicknames[petID] = pet.Nickname
+		}
+	}
+
+	// Step 3: Return the status, outcome and log.
+	response := &BattleLogResponse{
+		Status:       battle.Status,
+		Challenger:   nicknames[battle.ChallengerPet],
+		Opponent:     nicknames[battle.OpponentPet],
+		ChallengerHP: battle.ChallengerHP,
+		OpponentHP:   battle.OpponentHP,
+		Winner:       nicknames[battle.Winner],
+		Log:          make([]BattleLogEntry, 0, len(log.Rounds)),
+	}
+	for _, round := range log.Rounds {
+		response.Log = append(response.Log, BattleLogEntry{
+			Round:      round.Round,
+			Tick:       round.Tick,
+			Attacker:   nicknames[round.Attacker],
+			Defender:   nicknames[round.Defender],
+			Damage:     round.Damage,
+			DefenderHP: round.DefenderHP,
+		})
+	}
+	return response, nil
+}
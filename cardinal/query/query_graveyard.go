@@ -0,0 +1,68 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"tamagotchi/component"
+
+	"pkg.world.dev/world-engine/cardinal"
+)
+
+// Flow:
+// 1. Find the player entity with the given persona tag.
+// 2. Iterate over the player's graveyard and retrieve each pet and its Deceased component.
+// 3. Return the list of graves.
+type GraveyardMsg struct {
+	// The persona tag of the player to query.
+	PersonaTag string `json:"personaTag"`
+}
+
+// Grave describes a deceased pet.
+type Grave struct {
+	Nickname string `json:"nickname"`
+	Cause    string `json:"cause"`
+	BornTick uint64 `json:"born_tick"`
+	DiedTick uint64 `json:"died_tick"`
+}
+
+// GraveyardReply represents the response to a graveyard query.
+type GraveyardReply struct {
+	// The deceased pets of the player, in order of death.
+	Graves []Grave `json:"graves"`
+}
+
+/**
+ * QueryGraveyard queries the deceased pets of a player.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the player's graves, or an error if the query fails.
+ */
+func QueryGraveyard(world cardinal.WorldContext, req *GraveyardMsg) (*GraveyardReply, error) {
+	// Step 1: Find the player entity with the given persona tag.
+	graves := make([]Grave, 0)
+	playerID, err := component.FindPlayerByPersonaTag(world, req.PersonaTag)
+	if err != nil {
+		return &GraveyardReply{Graves: graves}, err
+	}
+	player, err := cardinal.GetComponent[component.Player](world, playerID)
+	if err != nil {
+		return &GraveyardReply{Graves: graves}, err
+	}
+
+	// Step 2: Iterate over the player's graveyard.
+	for _, petID := range player.Graveyard {
+		pet, err := cardinal.GetComponent[component.Pet](world, petID)
+		if err != nil {
+			continue
+		}
+		grave := Grave{Nickname: pet.Nickname, BornTick: pet.BornTick}
+		if deceased, ok := component.GetPetDeceased(world, petID); ok {
+			grave.Cause = deceased.Cause
+			grave.DiedTick = deceased.DiedTick
+		}
+		graves = append(graves, grave)
+	}
+
+	// Step 3: Return the list of graves.
+	return &GraveyardReply{Graves: graves}, nil
+}
@@ -0,0 +1,119 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"sort"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+// Flow:
+// 1. Search all marketplace listings.
+// 2. Keep the listings matching the kind and price range.
+// 3. Sort them by price, cheapest first, and return the requested page.
+type MarketListingsRequest struct {
+	// Kind filters by `Item.Kind` ("Food", "Toy", "Care") or "Pet". Empty matches every kind.
+	Kind string `json:"kind"`
+	// MinPrice and MaxPrice filter by listing price in minor units. Zero means no bound.
+	MinPrice game.Money `json:"minPrice"`
+	MaxPrice game.Money `json:"maxPrice"`
+	// Page is the zero-based page to return.
+	Page int `json:"page"`
+	// PageSize is the number of listings per page, `game.MarketPageSize` when omitted.
+	PageSize int `json:"pageSize"`
+}
+
+// MarketListing describes a listing for sale.
+type MarketListing struct {
+	ListingID   types.EntityID `json:"listing_id"`
+	Seller      string         `json:"seller"`
+	Kind        string         `json:"kind"`
+	Name        string         `json:"name"`
+	Quantity    int            `json:"quantity"`
+	Price       game.Money     `json:"price"`
+	ExpiresTick uint64         `json:"expires_tick"`
+}
+
+// MarketListingsResponse represents the response to a market listings query.
+type MarketListingsResponse struct {
+	// Listings of the page, cheapest first.
+	Listings []MarketListing `json:"listings"`
+	Page     int             `json:"page"`
+	PageSize int             `json:"pageSize"`
+	// Total is the number of listings matching the filters.
+	Total int `json:"total"`
+}
+
+/**
+ * QueryMarketListings pages through the marketplace listings.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing a page of listings, or an error if the query fails.
+ */
+func QueryMarketListings(world cardinal.WorldContext, req *MarketListingsRequest) (*MarketListingsResponse, error) {
+	// Step 1: Search all marketplace listings.
+	matches := make([]MarketListing, 0)
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Listing]())).
+		Each(world, func(listingID types.EntityID) bool {
+			listing, err := cardinal.GetComponent[component.Listing](world, listingID)
+			if err != nil {
+				return true
+			}
+
+			// Step 2: Keep the listings matching the filters.
+			if req.Kind != "" && listing.Kind != req.Kind {
+				return true
+			}
+			if listing.Price < req.MinPrice || (req.MaxPrice > 0 && listing.Price > req.MaxPrice) {
+				return true
+			}
+			matches = append(matches, MarketListing{
+				ListingID:   listingID,
+				Seller:      listing.Seller,
+				Kind:        listing.Kind,
+				Name:        listing.Label,
+				Quantity:    listing.Quantity,
+				Price:       listing.Price,
+				ExpiresTick: listing.ExpiresTick,
+			})
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Sort by price and return the requested page.
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Price != matches[j].Price {
+			return matches[i].Price < matches[j].Price
+		}
+		return matches[i].ListingID < matches[j].ListingID
+	})
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = game.MarketPageSize
+	}
+	pageSize = min(pageSize, game.MarketMaxPageSize)
+	page := max(req.Page, 0)
+
+	total := len(matches)
+	start := total
+	if page <= total/pageSize {
+		start = page * pageSize
+	}
+	end := min(start+pageSize, total)
+
+	return &MarketListingsResponse{
+		Listings: matches[start:end],
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+	}, nil
+}
@@ -0,0 +1,116 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"tamagotchi/component"
+	"tamagotchi/game"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+// Flow:
+// 1. Search all pending trades.
+// 2. Keep the trades proposed by or offered to the persona, resolving item and pet names.
+// 3. Return the outgoing and incoming trades.
+type PendingTradesRequest struct {
+	// The persona tag of the player to query.
+	PersonaTag string `json:"personaTag"`
+}
+
+// TradeItem is a quantity of an item in a trade.
+type TradeItem struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+}
+
+// TradeSide describes what one side of a trade gives.
+type TradeSide struct {
+	Items []TradeItem `json:"items"`
+	Money game.Money  `json:"money"`
+	Pets  []string    `json:"pets"`
+}
+
+// PendingTrade describes a trade waiting to be accepted.
+type PendingTrade struct {
+	TradeID     types.EntityID `json:"trade_id"`
+	Proposer    string         `json:"proposer"`
+	Recipient   string         `json:"recipient"`
+	Offer       TradeSide      `json:"offer"`
+	Request     TradeSide      `json:"request"`
+	CreatedTick uint64         `json:"created_tick"`
+	ExpiresTick uint64         `json:"expires_tick"`
+}
+
+// PendingTradesResponse represents the response to a pending trades query.
+type PendingTradesResponse struct {
+	// Outgoing are the trades proposed by the persona.
+	Outgoing []PendingTrade `json:"outgoing"`
+	// Incoming are the trades offered to the persona.
+	Incoming []PendingTrade `json:"incoming"`
+}
+
+/**
+ * QueryPendingTrades lists the trades a persona is waiting on.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the persona's outgoing and incoming trades, or an error if the query fails.
+ */
+func QueryPendingTrades(world cardinal.WorldContext, req *PendingTradesRequest) (*PendingTradesResponse, error) {
+	response := &PendingTradesResponse{Outgoing: make([]PendingTrade, 0), Incoming: make([]PendingTrade, 0)}
+
+	// Step 1: Search all pending trades.
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Trade]())).
+		Each(world, func(tradeID types.EntityID) bool {
+			trade, err := cardinal.GetComponent[component.Trade](world, tradeID)
+			if err != nil || !trade.Involves(req.PersonaTag) {
+				return true
+			}
+
+			// Step 2: Resolve the item and pet names of the trade.
+			pending := PendingTrade{
+				TradeID:     tradeID,
+				Proposer:    trade.Proposer,
+				Recipient:   trade.Recipient,
+				Offer:       tradeSide(world, trade.Offer),
+				Request:     tradeSide(world, trade.Request),
+				CreatedTick: trade.CreatedTick,
+				ExpiresTick: trade.ExpiresTick,
+			}
+			if trade.Proposer == req.PersonaTag {
+				response.Outgoing = append(response.Outgoing, pending)
+			} else {
+				response.Incoming = append(response.Incoming, pending)
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 3: Return the outgoing and incoming trades.
+	return response, nil
+}
+
+// tradeSide resolves the item names and pet nicknames of an escrow.
+func tradeSide(world cardinal.WorldContext, escrow component.Escrow) TradeSide {
+	side := TradeSide{Items: make([]TradeItem, 0), Money: escrow.Money, Pets: make([]string, 0)}
+	for _, stack := range escrow.Items {
+		item, err := cardinal.GetComponent[component.Item](world, stack.ItemID)
+		if err != nil {
+			continue
+		}
+		side.Items = append(side.Items, TradeItem{Name: item.ItemName, Quantity: stack.Quantity})
+	}
+	for _, petID := range escrow.Pets {
+		pet, err := cardinal.GetComponent[component.Pet](world, petID)
+		if err != nil {
+			continue
+		}
+		side.Pets = append(side.Pets, pet.Nickname)
+	}
+	return side
+}
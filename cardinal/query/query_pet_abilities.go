@@ -0,0 +1,68 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"tamagotchi/component"
+
+	"pkg.world.dev/world-engine/cardinal"
+)
+
+// Flow:
+// 1. Find the pet with the given nickname.
+// 2. Retrieve its Magic and Skill components.
+// 3. Return the progress of each ability towards its next level.
+type PetAbilitiesRequest struct {
+	// The nickname of the pet to query.
+	Nickname string `json:"nickname"`
+}
+
+// AbilityProgress describes the level of a Magic or Skill and the progress towards the next one.
+type AbilityProgress struct {
+	Kind        string `json:"kind"`
+	Level       int64  `json:"lvl"`
+	XP          int64  `json:"exp"`
+	NextLevelXP int64  `json:"next_lvl_exp"`
+	// Percentage is how far the ability is towards its next level.
+	Percentage int `json:"percentage"`
+}
+
+// PetAbilitiesResponse represents the response to a pet abilities query.
+type PetAbilitiesResponse struct {
+	// Magic and Skill are null for pets created before every pet had them, until they first train.
+	Magic *AbilityProgress `json:"magic"`
+	Skill *AbilityProgress `json:"skill"`
+}
+
+/**
+ * QueryPetAbilities queries the Magic and Skill progress of a pet.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the progress of the pet's abilities, or an error if the query fails.
+ */
+func QueryPetAbilities(world cardinal.WorldContext, req *PetAbilitiesRequest) (*PetAbilitiesResponse, error) {
+	// Step 1: Find the pet.
+	petID, _, err := component.GetPetByNickname(world, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2 and 3: Report the progress of each ability.
+	response := &PetAbilitiesResponse{}
+	if magic, err := cardinal.GetComponent[component.Magic](world, petID); err == nil {
+		response.Magic = abilityProgress(magic.Kind, magic.Level, magic.XP, magic.NextLevelXP)
+	}
+	if skill, err := cardinal.GetComponent[component.Skill](world, petID); err == nil {
+		response.Skill = abilityProgress(skill.Kind, skill.Level, skill.XP, skill.NextLevelXP)
+	}
+	return response, nil
+}
+
+// abilityProgress builds the progress of an ability.
+func abilityProgress(kind string, level int64, xp int64, nextLevelXP int64) *AbilityProgress {
+	progress := &AbilityProgress{Kind: kind, Level: level, XP: xp, NextLevelXP: nextLevelXP}
+	if nextLevelXP > 0 {
+		progress.Percentage = int(xp * 100 / nextLevelXP)
+	}
+	return progress
+}
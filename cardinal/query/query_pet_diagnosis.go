@@ -0,0 +1,100 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+// Flow:
+// 1. Find the pet with the given nickname.
+// 2. Read its disease, if any, with its symptoms and the drugs curing it.
+// 3. Read the immunities still protecting the pet.
+// 4. For a healthy pet, work out its chance to fall sick from its low hygiene and health.
+type PetDiagnosisRequest struct {
+	// The nickname of the pet to diagnose.
+	Nickname string `json:"nickname"`
+}
+
+// PetDiagnosisResponse represents the response to a pet diagnosis query.
+type PetDiagnosisResponse struct {
+	// Whether the pet is sick, the other disease fields are empty when it is not.
+	Sick bool `json:"sick"`
+	// The disease and its class, see `game.Diseases`.
+	Disease string `json:"disease"`
+	Class   string `json:"class"`
+	// How bad the disease got, the pet losing health at the max severity.
+	Severity    int    `json:"severity"`
+	MaxSeverity int    `json:"max_severity"`
+	Since       uint64 `json:"since"`
+	// What the disease does to the pet, and the activities it is too sick for.
+	Symptoms []string `json:"symptoms"`
+	Blocks   []string `json:"blocks"`
+	// The drugs curing the disease.
+	Cures []string `json:"cures"`
+	// The classes of diseases the pet cannot catch, and until when.
+	Immunities []component.Immunity `json:"immunities"`
+	// The percentage of chance of a healthy pet to fall sick every `game.SicknessTickRate` ticks.
+	Risk int `json:"risk"`
+}
+
+/**
+ * QueryPetDiagnosis diagnoses the disease of a pet and tells how to cure it.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the diagnosis of the pet, or an error if the pet does not exist.
+ */
+func QueryPetDiagnosis(world cardinal.WorldContext, req *PetDiagnosisRequest) (*PetDiagnosisResponse, error) {
+	// Step 1: Find the pet.
+	petID, _, err := component.GetPetByNickname(world, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	response := &PetDiagnosisResponse{
+		MaxSeverity: game.MaxSeverity,
+		Symptoms:    make([]string, 0),
+		Blocks:      make([]string, 0),
+		Cures:       make([]string, 0),
+		Immunities:  make([]component.Immunity, 0),
+	}
+
+	sickness, ok := component.GetPetSickness(world, petID)
+	if ok {
+		// Step 2: Read the disease.
+		if disease, sick := sickness.Properties(); sick {
+			response.Sick = true
+			response.Disease = disease.Name
+			response.Class = disease.Class
+			response.Severity = sickness.Severity
+			response.Since = sickness.Since
+			response.Symptoms = append(response.Symptoms, disease.Symptoms...)
+			response.Blocks = append(response.Blocks, disease.Blocks...)
+			catalog, err := game.Catalog()
+			if err != nil {
+				return nil, err
+			}
+			response.Cures = append(response.Cures, catalog.Cures(disease.Name)...)
+		}
+
+		// Step 3: Read the immunities.
+		for _, immunity := range sickness.Immunities {
+			if world.CurrentTick() < immunity.ExpiresTick {
+				response.Immunities = append(response.Immunities, immunity)
+			}
+		}
+	}
+
+	// Step 4: Work out the risk of a healthy pet.
+	if !response.Sick {
+		if hygiene, err := cardinal.GetComponent[component.Hygiene](world, petID); err == nil {
+			response.Risk += game.SicknessChance(hygiene.Hy)
+		}
+		if health, err := cardinal.GetComponent[component.Health](world, petID); err == nil {
+			response.Risk += game.SicknessChance(health.HP)
+		}
+	}
+	return response, nil
+}
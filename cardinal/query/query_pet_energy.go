@@ -14,7 +14,7 @@ import (
 // Flow:
 // 1. Initialize the search query to find entities with Pet and Energy components.
 // 2. Iterate over the entities that match the search criteria and check if the Pet component matches the requested nickname.
-// 3. If a match is found, retrieve the Energy component and return its value.
+// 3. If a match is found, retrieve the Energy component and the pet's life stage and return them.
 // 4. Return an error if no match is found or if the query fails.
 type PetEnergyRequest struct {
 	// The nickname of the pet to query.
@@ -25,6 +25,8 @@ type PetEnergyRequest struct {
 type PetEnergyResponse struct {
 	// The energy value of the pet.
 	E int
+	// The current life stage of the pet (Egg, Baby, Child, Teen, Adult or Elder).
+	Stage string
 }
 
 /**
@@ -37,6 +39,7 @@ type PetEnergyResponse struct {
 func QueryPetEnergy(world cardinal.WorldContext, req *PetEnergyRequest) (*PetEnergyResponse, error) {
 	// Step 1: Initialize the search query to find entities with Pet and Energy components.
 	var petEnergy *component.Energy
+	var stage string
 	var err error
 	log := world.Logger()
 	log.Info().Msgf("Received payload to query-pet-energy: Name[%s]", req.Nickname)
@@ -45,7 +48,7 @@ func QueryPetEnergy(world cardinal.WorldContext, req *PetEnergyRequest) (*PetEne
 		filter.Contains(filter.Component[component.Pet](), filter.Component[component.Energy]()))
 
 	// Step 2: Iterate over the entities that match the search criteria and check if the Pet component matches the requested nickname.
-	// Step 3: If a match is found, retrieve the Energy component and return its value.
+	// Step 3: If a match is found, retrieve the Energy component and the pet's life stage.
 	searchErr := q.
 		Each(world, func(id types.EntityID) bool {
 			var pet *component.Pet
@@ -60,6 +63,7 @@ func QueryPetEnergy(world cardinal.WorldContext, req *PetEnergyRequest) (*PetEne
 				if err != nil {
 					return false
 				}
+				stage = petStage(world, id)
 				return false
 			}
 
@@ -78,5 +82,5 @@ func QueryPetEnergy(world cardinal.WorldContext, req *PetEnergyRequest) (*PetEne
 		return nil, fmt.Errorf("pet %s does not exist", req.Nickname)
 	}
 
-	return &PetEnergyResponse{E: petEnergy.E}, nil
+	return &PetEnergyResponse{E: petEnergy.E, Stage: stage}, nil
 }
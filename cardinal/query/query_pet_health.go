@@ -14,7 +14,7 @@ import (
 // Flow:
 // 1. Initialize the search query to find entities with Pet and Health components.
 // 2. Iterate over the entities that match the search criteria and check if the Pet component matches the requested nickname.
-// 3. If a match is found, retrieve the Health component and return its value.
+// 3. If a match is found, retrieve the Health component and the pet's life stage and return them.
 // 4. Return an error if no match is found or if the query fails.
 type PetHealthRequest struct {
 	// The nickname of the pet to query.
@@ -25,6 +25,8 @@ type PetHealthRequest struct {
 type PetHealthResponse struct {
 	// The health value of the pet.
 	HP int
+	// The current life stage of the pet (Egg, Baby, Child, Teen, Adult or Elder).
+	Stage string
 }
 
 /**
@@ -37,13 +39,14 @@ type PetHealthResponse struct {
 func QueryPetHealth(world cardinal.WorldContext, req *PetHealthRequest) (*PetHealthResponse, error) {
 	// Step 1: Initialize the search query to find entities with Pet and Health components.
 	var petHealth *component.Health
+	var stage string
 	var err error
 
 	q := cardinal.NewSearch().Entity(
 		filter.Contains(filter.Component[component.Pet](), filter.Component[component.Health]()))
 
 	// Step 2: Iterate over the entities that match the search criteria and check if the Pet component matches the requested nickname.
-	// Step 3: If a match is found, retrieve the Health component and return its value.
+	// Step 3: If a match is found, retrieve the Health component and the pet's life stage.
 	searchErr := q.
 		Each(world, func(id types.EntityID) bool {
 			var pet *component.Pet
@@ -58,6 +61,7 @@ func QueryPetHealth(world cardinal.WorldContext, req *PetHealthRequest) (*PetHea
 				if err != nil {
 					return false
 				}
+				stage = petStage(world, id)
 				return false
 			}
 
@@ -76,5 +80,16 @@ func QueryPetHealth(world cardinal.WorldContext, req *PetHealthRequest) (*PetHea
 		return nil, fmt.Errorf("pet %s does not exist", req.Nickname)
 	}
 
-	return &PetHealthResponse{HP: petHealth.HP}, nil
+	return &PetHealthResponse{HP: petHealth.HP, Stage: stage}, nil
+}
+
+/**
+ * petStage returns the name of the pet's current life stage, or an empty string if the pet has no LifeStage.
+ */
+func petStage(world cardinal.WorldContext, petId types.EntityID) string {
+	stage, err := component.GetPetLifeStage(world, petId)
+	if err != nil {
+		return ""
+	}
+	return stage.Stage
 }
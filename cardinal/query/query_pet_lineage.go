@@ -0,0 +1,117 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"fmt"
+	"tamagotchi/component"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+)
+
+// Flow:
+// 1. Find the pet entity with the requested nickname.
+// 2. Read its Lineage component to find its parents.
+// 3. Read the parents' Lineage components to find the grandparents.
+// 4. Return the family tree together with the gene contributions of the pet.
+type PetLineageRequest struct {
+	// The nickname of the pet to query.
+	Nickname string `json:"nickname"`
+}
+
+// LineageMember describes one pet of a family tree.
+type LineageMember struct {
+	Nickname string        `json:"nickname"`
+	Dna      component.Dna `json:"dna"`
+	Magic    string        `json:"magic"`
+	Skill    string        `json:"skill"`
+	Stage    string        `json:"stage"`
+	// Founder is true when the pet was not bred (it has no parents).
+	Founder bool `json:"founder"`
+}
+
+// PetLineageResponse represents the response to a pet lineage query.
+type PetLineageResponse struct {
+	Pet          LineageMember                `json:"pet"`
+	Father       *LineageMember               `json:"father,omitempty"`
+	Mother       *LineageMember               `json:"mother,omitempty"`
+	Grandparents []LineageMember              `json:"grandparents"`
+	Genes        []component.GeneContribution `json:"genes"`
+}
+
+/**
+ * QueryPetLineage queries the parents, grandparents and gene contributions of a pet.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the family tree of the pet, or an error if the query fails.
+ */
+func QueryPetLineage(world cardinal.WorldContext, req *PetLineageRequest) (*PetLineageResponse, error) {
+	// Step 1: Find the pet entity with the requested nickname.
+	log := world.Logger()
+	log.Info().Msgf("Received payload to query-pet-lineage: Name[%s]", req.Nickname)
+
+	found, petId, err := component.QueryPetIdByName(world, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("pet %s does not exist", req.Nickname)
+	}
+
+	response := &PetLineageResponse{
+		Pet:          lineageMember(world, petId),
+		Grandparents: make([]LineageMember, 0),
+		Genes:        make([]component.GeneContribution, 0),
+	}
+
+	// Step 2: Read its Lineage component to find its parents.
+	lineage, ok := component.GetPetLineage(world, petId)
+	if !ok {
+		return response, nil
+	}
+	response.Genes = lineage.Genes
+	father := lineageMember(world, lineage.FatherID)
+	father.Nickname = lineage.Father
+	mother := lineageMember(world, lineage.MotherID)
+	mother.Nickname = lineage.Mother
+	response.Father = &father
+	response.Mother = &mother
+
+	// Step 3: Read the parents' Lineage components to find the grandparents.
+	for _, parentId := range []types.EntityID{lineage.FatherID, lineage.MotherID} {
+		parentLineage, ok := component.GetPetLineage(world, parentId)
+		if !ok {
+			continue
+		}
+		grandfather := lineageMember(world, parentLineage.FatherID)
+		grandfather.Nickname = parentLineage.Father
+		grandmother := lineageMember(world, parentLineage.MotherID)
+		grandmother.Nickname = parentLineage.Mother
+		response.Grandparents = append(response.Grandparents, grandfather, grandmother)
+	}
+
+	// Step 4: Return the family tree.
+	return response, nil
+}
+
+// lineageMember collects the genetic information of a pet. Missing components are left empty.
+func lineageMember(world cardinal.WorldContext, petId types.EntityID) LineageMember {
+	member := LineageMember{}
+	if pet, err := cardinal.GetComponent[component.Pet](world, petId); err == nil {
+		member.Nickname = pet.Nickname
+	}
+	if dna, err := cardinal.GetComponent[component.Dna](world, petId); err == nil {
+		member.Dna = *dna
+	}
+	if magic, err := cardinal.GetComponent[component.Magic](world, petId); err == nil {
+		member.Magic = magic.Kind
+	}
+	if skill, err := cardinal.GetComponent[component.Skill](world, petId); err == nil {
+		member.Skill = skill.Kind
+	}
+	member.Stage = petStage(world, petId)
+	_, bred := component.GetPetLineage(world, petId)
+	member.Founder = !bred
+	return member
+}
@@ -0,0 +1,56 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+// Flow:
+// 1. Find the pet with the given nickname.
+// 2. Read its current activity and the actions queued after it.
+// 3. Return them with the maximum length of the queue.
+type PetQueueRequest struct {
+	// The nickname of the pet to query.
+	Nickname string `json:"nickname"`
+}
+
+// PetQueueResponse represents the response to a pet queue query.
+type PetQueueResponse struct {
+	// The activity the pet is engaged in.
+	Activity component.Activity `json:"activity"`
+	// The actions waiting to start, next first.
+	Queue []component.QueuedActivity `json:"queue"`
+	// The maximum number of queued actions.
+	MaxLength int `json:"max_length"`
+}
+
+/**
+ * QueryPetQueue queries the current activity of a pet and the actions queued after it.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the queue of the pet, or an error if the pet does not exist.
+ */
+func QueryPetQueue(world cardinal.WorldContext, req *PetQueueRequest) (*PetQueueResponse, error) {
+	// Step 1: Find the pet.
+	petID, _, err := component.GetPetByNickname(world, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Read the activity and the queue.
+	activity, err := component.GetPetActivity(world, petID)
+	if err != nil {
+		return nil, err
+	}
+	queue := make([]component.QueuedActivity, 0)
+	if petQueue, ok := component.GetPetQueue(world, petID); ok {
+		queue = append(queue, petQueue.Pending...)
+	}
+
+	// Step 3: Return the queue.
+	return &PetQueueResponse{Activity: *activity, Queue: queue, MaxLength: game.MaxActivityQueue}, nil
+}
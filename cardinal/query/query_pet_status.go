@@ -0,0 +1,142 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+)
+
+// Flow:
+// 1. Find the pet with the given nickname.
+// 2. Read every component of the pet (see `petStatus`).
+// 3. Return them in one response.
+type PetStatusRequest struct {
+	// The nickname of the pet to query.
+	Nickname string `json:"nickname"`
+}
+
+// PetStatus holds every component of a pet. Optional components are null when the pet does not have them,
+// e.g. Deceased for living pets or Lineage for founder pets.
+type PetStatus struct {
+	ID       types.EntityID      `json:"id"`
+	Pet      component.Pet       `json:"pet"`
+	Health   int                 `json:"health"`
+	Energy   int                 `json:"energy"`
+	Hygiene  int                 `json:"hygiene"`
+	Wellness int                 `json:"wellness"`
+	Satiety  int                 `json:"satiety"`
+	Stage    string              `json:"stage"`
+	Mood     *component.Mood     `json:"mood"`
+	Activity *component.Activity `json:"activity"`
+	Think    string              `json:"think"`
+	Dna      *component.Dna      `json:"dna"`
+	Traits   []string            `json:"traits"`
+	Magic    *component.Magic    `json:"magic"`
+	Skill    *component.Skill    `json:"skill"`
+	Lineage  *component.Lineage  `json:"lineage"`
+	Deceased *component.Deceased `json:"deceased"`
+	Buffs    []component.Buff    `json:"buffs"`
+}
+
+// PetStatusResponse represents the response to a pet status query.
+type PetStatusResponse struct {
+	Status PetStatus `json:"status"`
+}
+
+/**
+ * QueryPetStatus queries every component of a pet at once.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the status of the pet, or an error if the pet does not exist.
+ */
+func QueryPetStatus(world cardinal.WorldContext, req *PetStatusRequest) (*PetStatusResponse, error) {
+	// Step 1: Find the pet.
+	petID, _, err := component.GetPetByNickname(world, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2 and 3: Read every component of the pet.
+	status, err := petStatus(world, petID)
+	if err != nil {
+		return nil, err
+	}
+	return &PetStatusResponse{Status: *status}, nil
+}
+
+/**
+ * petStatus reads every component of a pet.
+ *
+ * Code Flow:
+ * 1. Read the Pet component, which every pet has.
+ * 2. Read the stats, reporting 0 for a stat the pet does not have.
+ * 3. Read the optional components, leaving them null when the pet does not have them.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The game world context.
+ *   petID (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (*PetStatus, error): The status of the pet, and an error if the entity is not a pet.
+ */
+func petStatus(world cardinal.WorldContext, petID types.EntityID) (*PetStatus, error) {
+	// Step 1: Read the Pet component.
+	pet, err := cardinal.GetComponent[component.Pet](world, petID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pet status [get Pet %d]: %w", petID, err)
+	}
+	status := &PetStatus{ID: petID, Pet: *pet, Stage: petStage(world, petID)}
+
+	// Step 2: Read the stats.
+	if health, err := cardinal.GetComponent[component.Health](world, petID); err == nil {
+		status.Health = health.HP
+	}
+	if energy, err := cardinal.GetComponent[component.Energy](world, petID); err == nil {
+		status.Energy = energy.E
+	}
+	if hygiene, err := cardinal.GetComponent[component.Hygiene](world, petID); err == nil {
+		status.Hygiene = hygiene.Hy
+	}
+	if wellness, err := cardinal.GetComponent[component.Wellness](world, petID); err == nil {
+		status.Wellness = wellness.Wn
+	}
+	if hunger, err := cardinal.GetComponent[component.Hunger](world, petID); err == nil {
+		status.Satiety = hunger.Satiety
+	}
+
+	// Step 3: Read the optional components.
+	if mood, ok := component.GetPetMood(world, petID); ok {
+		status.Mood = mood
+	}
+	if activity, err := cardinal.GetComponent[component.Activity](world, petID); err == nil {
+		status.Activity = activity
+	}
+	if think, err := cardinal.GetComponent[component.Think](world, petID); err == nil {
+		status.Think = think.Think
+	}
+	if dna, err := cardinal.GetComponent[component.Dna](world, petID); err == nil {
+		status.Dna = dna
+	}
+	status.Traits = component.GetPetTraits(world, petID)
+	if magic, err := cardinal.GetComponent[component.Magic](world, petID); err == nil {
+		status.Magic = magic
+	}
+	if skill, err := cardinal.GetComponent[component.Skill](world, petID); err == nil {
+		status.Skill = skill
+	}
+	if lineage, ok := component.GetPetLineage(world, petID); ok {
+		status.Lineage = lineage
+	}
+	if deceased, ok := component.GetPetDeceased(world, petID); ok {
+		status.Deceased = deceased
+	}
+	if buffs, ok := component.GetPetBuffs(world, petID); ok {
+		status.Buffs = buffs.Active
+	}
+	return status, nil
+}
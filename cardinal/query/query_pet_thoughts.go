@@ -0,0 +1,64 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+// Flow:
+// 1. Find the pet with the given nickname.
+// 2. Read its current thought and the thoughts it remembers.
+// 3. Return the last Limit thoughts, newest first.
+type PetThoughtsRequest struct {
+	// The nickname of the pet to query.
+	Nickname string `json:"nickname"`
+	// The number of thoughts to return, every remembered thought when zero.
+	Limit int `json:"limit"`
+}
+
+// PetThoughtsResponse represents the response to a pet thoughts query.
+type PetThoughtsResponse struct {
+	// What the pet is thinking now.
+	Think string `json:"think"`
+	// The last thoughts of the pet with the tick it had them, newest first.
+	Thoughts []component.ThoughtEntry `json:"thoughts"`
+}
+
+/**
+ * QueryPetThoughts queries the last thoughts of a pet.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the thoughts of the pet, or an error if the pet does not exist.
+ */
+func QueryPetThoughts(world cardinal.WorldContext, req *PetThoughtsRequest) (*PetThoughtsResponse, error) {
+	// Step 1: Find the pet.
+	petID, _, err := component.GetPetByNickname(world, req.Nickname)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Read the current thought and the history.
+	think, err := component.GetPetThink(world, petID)
+	if err != nil {
+		return nil, err
+	}
+	var history []component.ThoughtEntry
+	if thoughts, ok := component.GetPetThoughts(world, petID); ok {
+		history = thoughts.History
+	}
+
+	// Step 3: Return the last thoughts, newest first.
+	limit := req.Limit
+	if limit <= 0 || limit > game.MaxThoughtHistory {
+		limit = game.MaxThoughtHistory
+	}
+	result := make([]component.ThoughtEntry, 0, limit)
+	for i := len(history) - 1; i >= 0 && len(result) < limit; i-- {
+		result = append(result, history[i])
+	}
+	return &PetThoughtsResponse{Think: think.Think, Thoughts: result}, nil
+}
@@ -9,18 +9,24 @@ import (
 
 // Flow:
 // 1. Find the player entity with the given persona tag.
-// 2. Retrieve the player's items component.
-// 3. Iterate over the items and retrieve each item's component.
-// 4. Return a list of item components.
+// 2. Retrieve the player's inventory stacks (legacy items included).
+// 3. Iterate over the stacks and retrieve each item's component.
+// 4. Return a list of items with the quantity owned.
 type ItemListMsg struct {
 	// The persona tag of the player to query.
 	PersonaTag string `json:"personaTag"`
 }
 
+// InventoryItem is an item owned by the player and how many units of it they own.
+type InventoryItem struct {
+	component.Item
+	Quantity int `json:"quantity"`
+}
+
 // ItemListReply represents the response to a player items query.
 type ItemListReply struct {
-	// The list of items belonging to the player.
-	ItemList []component.Item `json:"items"`
+	// The list of items belonging to the player, one entry per item.
+	ItemList []InventoryItem `json:"items"`
 }
 
 /**
@@ -35,7 +41,7 @@ func QueryPlayerItems(world cardinal.WorldContext, req *ItemListMsg) (*ItemListR
 	var err error
 	log := world.Logger()
 	log.Info().Msgf("Received payload to query-PerosnaItemList")
-	list := make([]component.Item, 0)
+	list := make([]InventoryItem, 0)
 
 	playerID, err := component.FindPlayerByPersonaTag(world, req.PersonaTag)
 	if err != nil {
@@ -43,31 +49,26 @@ func QueryPlayerItems(world cardinal.WorldContext, req *ItemListMsg) (*ItemListR
 		return &ItemListReply{ItemList: list}, err
 	}
 
-	// Step 2: Retrieve the player's items component.
+	// Step 2: Retrieve the player's inventory stacks.
 	player, err := cardinal.GetComponent[component.Player](world, playerID)
 	if err != nil {
 		return &ItemListReply{ItemList: list}, err
 	}
-
-	// Step 3: Iterate over the items and retrieve each item's component.
-	items := make([]component.Item, 0)
-
-	if len(player.Items) == 0 {
+	stacks := player.Stacks()
+	if len(stacks) == 0 {
 		log.Info().Msgf("Player has no items")
 	}
-	if len(player.Pets) == 0 {
-		log.Info().Msgf("Player has no pets")
-	}
-	for _, itemID := range player.Items {
-		item, err := cardinal.GetComponent[component.Item](world, itemID)
+
+	// Step 3: Iterate over the stacks and retrieve each item's component.
+	for _, stack := range stacks {
+		item, err := cardinal.GetComponent[component.Item](world, stack.ItemID)
 		if err != nil {
 			log.Info().Msgf("QueryPlayerItems Error [%s]", err)
 			continue
 		}
-		items = append(items, *item)
+		list = append(list, InventoryItem{Item: *item, Quantity: stack.Quantity})
 	}
-	list = append(list, items...)
 
-	// Step 4: Return a list of item components.
+	// Step 4: Return a list of items with their quantities.
 	return &ItemListReply{ItemList: list}, nil
 }
@@ -0,0 +1,80 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"tamagotchi/component"
+	"tamagotchi/game"
+
+	"pkg.world.dev/world-engine/cardinal"
+)
+
+// Flow:
+// 1. Find the player entity with the given persona tag.
+// 2. Retrieve the player's ledger.
+// 3. Return the requested page of entries, newest first, with the current balance.
+type PlayerLedgerRequest struct {
+	// The persona tag of the player to query.
+	PersonaTag string `json:"personaTag"`
+	// Page is the zero-based page to return.
+	Page int `json:"page"`
+	// PageSize is the number of entries per page, `game.LedgerPageSize` when omitted.
+	PageSize int `json:"pageSize"`
+}
+
+// PlayerLedgerResponse represents the response to a player ledger query.
+type PlayerLedgerResponse struct {
+	// Entries of the page, newest first.
+	Entries  []component.LedgerEntry `json:"entries"`
+	Page     int                     `json:"page"`
+	PageSize int                     `json:"pageSize"`
+	// Total is the number of entries in the whole ledger.
+	Total int `json:"total"`
+	// Balance is the player's current balance in minor units.
+	Balance game.Money `json:"balance"`
+}
+
+/**
+ * QueryPlayerLedger pages through the money history of a player.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing a page of ledger entries, or an error if the query fails.
+ */
+func QueryPlayerLedger(world cardinal.WorldContext, req *PlayerLedgerRequest) (*PlayerLedgerResponse, error) {
+	// Step 1: Find the player entity with the given persona tag.
+	playerID, err := component.FindPlayerByPersonaTag(world, req.PersonaTag)
+	if err != nil {
+		return nil, err
+	}
+	player, err := cardinal.GetComponent[component.Player](world, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Step 2: Retrieve the player's ledger.
+	history := component.GetPlayerLedger(world, playerID).Entries
+
+	// Step 3: Return the requested page, newest first.
+	pageSize := req.PageSize
+	if pageSize <= 0 {
+		pageSize = game.LedgerPageSize
+	}
+	pageSize = min(pageSize, game.LedgerMaxPageSize)
+	page := max(req.Page, 0)
+
+	total := len(history)
+	entries := make([]component.LedgerEntry, 0, pageSize)
+	if page <= total/pageSize {
+		for i := total - 1 - page*pageSize; i >= 0 && len(entries) < pageSize; i-- {
+			entries = append(entries, history[i])
+		}
+	}
+
+	return &PlayerLedgerResponse{
+		Entries:  entries,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+		Balance:  player.Money,
+	}, nil
+}
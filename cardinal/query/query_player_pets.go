@@ -0,0 +1,51 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"tamagotchi/component"
+
+	"pkg.world.dev/world-engine/cardinal"
+)
+
+// Flow:
+// 1. Find the player entity with the given persona tag.
+// 2. Read the status of each of the player's pets (see `petStatus`).
+// 3. Return the list of statuses.
+type PlayerPetsRequest struct {
+	// The persona tag of the player to query.
+	PersonaTag string `json:"personaTag"`
+}
+
+// PlayerPetsResponse represents the response to a player pets query.
+type PlayerPetsResponse struct {
+	// The status of each pet of the player, in the order of the player's pets.
+	Pets []PetStatus `json:"pets"`
+}
+
+/**
+ * QueryPlayerPets queries the status of every pet of a player at once.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the status of the player's pets, or an error if the player does not exist.
+ */
+func QueryPlayerPets(world cardinal.WorldContext, req *PlayerPetsRequest) (*PlayerPetsResponse, error) {
+	// Step 1: Find the player entity with the given persona tag.
+	pets := make([]PetStatus, 0)
+	player, err := component.GetPlayerByPersonaTag(world, req.PersonaTag)
+	if err != nil {
+		return &PlayerPetsResponse{Pets: pets}, err
+	}
+
+	// Step 2: Read the status of each pet, skipping pets that no longer exist.
+	for _, petID := range player.Pets {
+		status, err := petStatus(world, petID)
+		if err != nil {
+			continue
+		}
+		pets = append(pets, *status)
+	}
+
+	// Step 3: Return the list of statuses.
+	return &PlayerPetsResponse{Pets: pets}, nil
+}
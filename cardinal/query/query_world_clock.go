@@ -0,0 +1,37 @@
+// Package query contains functions to query game data.
+package query
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/game"
+)
+
+// Flow:
+// 1. Read the current tick of the world.
+// 2. Convert it into the time of day (see `game.ClockAt`).
+type WorldClockRequest struct{}
+
+// WorldClockResponse represents the response to a world clock query.
+type WorldClockResponse struct {
+	// The time of day: the day, hour and minute, the phase and the ticks until the next phase.
+	game.Clock
+	// Whether pets get sleepier and sleep better now.
+	IsNight bool `json:"is_night"`
+}
+
+/**
+ * QueryWorldClock queries the time of day of the game world.
+ *
+ * @param world The game world context.
+ * @param req The query request.
+ * @return A response containing the time of day.
+ */
+func QueryWorldClock(world cardinal.WorldContext, _ *WorldClockRequest) (*WorldClockResponse, error) {
+	// Step 1: Read the current tick.
+	tick := world.CurrentTick()
+
+	// Step 2: Convert it into the time of day.
+	clock := game.ClockAt(tick)
+	return &WorldClockResponse{Clock: clock, IsNight: clock.IsNight()}, nil
+}
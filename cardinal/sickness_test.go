@@ -0,0 +1,169 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+// TestDisease_Symptoms tests that the symptoms of a disease get worse with its severity, and that low stats make
+// pets liable to fall sick.
+func TestDisease_Symptoms(t *testing.T) {
+	// When / Then:
+	// - A cold makes energy decline faster as it gets worse, and does not change the other stats.
+	cold, ok := game.DiseaseByName(game.DiseaseCold)
+	assert.True(t, ok)
+	assert.Equal(t, 133, cold.DeclinePercent(game.StatEnergy, 1))
+	assert.Equal(t, 200, cold.DeclinePercent(game.StatEnergy, game.MaxSeverity))
+	assert.Equal(t, 100, cold.DeclinePercent(game.StatHygiene, game.MaxSeverity))
+
+	// - Only specific drugs of the catalog cure it.
+	catalog, err := game.Catalog()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"Pill", "Vitamin"}, catalog.Cures(game.DiseaseCold))
+
+	// - A fever keeps the pet from playing.
+	fever, ok := game.DiseaseByName(game.DiseaseFever)
+	assert.True(t, ok)
+	assert.True(t, fever.BlocksActivity(game.ActivityPlaying))
+	assert.False(t, cold.BlocksActivity(game.ActivityPlaying))
+
+	// - The lower a stat below the threshold, the higher the chance to fall sick.
+	assert.Zero(t, game.SicknessChance(game.SicknessThreshold))
+	assert.Equal(t, 1, game.SicknessChance(game.SicknessThreshold-1))
+	assert.Equal(t, game.SicknessThreshold/game.SicknessChanceRate, game.SicknessChance(0))
+}
+
+// TestSystem_PetCureAction_TargetedCure tests that a sick pet is too sick to play, that only the right drug cures
+// it, and that the diagnosis tells which one.
+func TestSystem_PetCureAction_TargetedCure(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a pet with a fever are created, and the player buys a toy and drugs.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	for _, item := range []string{playToyName, "Mineral", "Pill"} {
+		assert.NoError(t, buyToy(t, tf, item))
+	}
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, component.InfectPet(wCtx, petId, game.DiseaseFever))
+
+	// When / Then:
+	// - The diagnosis names the disease and its cure.
+	diagnosis, err := query.QueryPetDiagnosis(wCtx, &query.PetDiagnosisRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.True(t, diagnosis.Sick)
+	assert.Equal(t, game.DiseaseFever, diagnosis.Disease)
+	assert.Equal(t, 1, diagnosis.Severity)
+	assert.Equal(t, []string{"Pill"}, diagnosis.Cures)
+
+	// - The pet is too sick to play.
+	_, err = executeTx[msg.PlayPetMsgReply](t, tf, playMsgName,
+		msg.PlayPetMsg{TargetNickname: petName, ItemName: playToyName}, personaTag)
+	assert.ErrorContains(t, err, "too sick")
+
+	// - A drug that does not treat the disease does not cure it.
+	reply, err := executeTx[msg.CurePetMsgReply](t, tf, cureMsgName,
+		msg.CurePetMsg{TargetNickname: petName, ItemName: "Mineral"}, personaTag)
+	assert.NoError(t, err)
+	assert.Empty(t, reply.Cured)
+	sickness, ok := component.GetPetSickness(wCtx, petId)
+	assert.True(t, ok)
+	assert.Equal(t, game.DiseaseFever, sickness.Disease)
+
+	// - The right drug cures it, and the pet can play again.
+	reply, err = executeTx[msg.CurePetMsgReply](t, tf, cureMsgName,
+		msg.CurePetMsg{TargetNickname: petName, ItemName: "Pill"}, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, game.DiseaseFever, reply.Cured)
+	diagnosis, err = query.QueryPetDiagnosis(wCtx, &query.PetDiagnosisRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.False(t, diagnosis.Sick)
+	_, err = executeTx[msg.PlayPetMsgReply](t, tf, playMsgName,
+		msg.PlayPetMsg{TargetNickname: petName, ItemName: playToyName}, personaTag)
+	assert.NoError(t, err)
+}
+
+// TestSystem_PetCureAction_VaccinePrevents tests that a vaccine makes a pet immune to a class of diseases.
+func TestSystem_PetCureAction_VaccinePrevents(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a pet are created, and the player buys a vaccine.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	assert.NoError(t, buyToy(t, tf, "Vaccine"))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+
+	// When:
+	// - The pet is vaccinated.
+	reply, err := executeTx[msg.CurePetMsgReply](t, tf, cureMsgName,
+		msg.CurePetMsg{TargetNickname: petName, ItemName: "Vaccine"}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet is immune to viral diseases for a while, and only to those.
+	assert.Equal(t, []string{game.DiseaseViral}, reply.Prevents)
+	sickness, ok := component.GetPetSickness(wCtx, petId)
+	assert.True(t, ok)
+	assert.True(t, sickness.IsImmune(game.DiseaseViral, wCtx.CurrentTick()))
+	assert.False(t, sickness.IsImmune(game.DiseaseParasitic, wCtx.CurrentTick()))
+	assert.False(t, sickness.IsImmune(game.DiseaseViral, wCtx.CurrentTick()+game.ImmunityTicks))
+
+	// - The diagnosis lists the immunity.
+	diagnosis, err := query.QueryPetDiagnosis(wCtx, &query.PetDiagnosisRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.Len(t, diagnosis.Immunities, 1)
+	assert.Equal(t, game.DiseaseViral, diagnosis.Immunities[0].Class)
+}
+
+// TestSystem_HealthDeclineSystem_DiseaseAtMaxSeverity tests that a disease left untreated eats the pet's health.
+func TestSystem_HealthDeclineSystem_DiseaseAtMaxSeverity(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and an adult pet with a cold at its worst are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	setPetLifeStage(t, tf, petName, game.StageAdult)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, component.InfectPet(wCtx, petId, game.DiseaseCold))
+	sickness, ok := component.GetPetSickness(wCtx, petId)
+	assert.True(t, ok)
+	sickness.Severity = game.MaxSeverity
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, sickness))
+
+	// When:
+	// - A decline cycle passes.
+	for i := 0; i < game.DeclineTickRate; i++ {
+		tf.DoTick()
+	}
+
+	// Then:
+	// - The pet lost health, although it is well fed, clean and happy.
+	health, err := cardinal.GetComponent[component.Health](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Less(t, health.HP, game.MaxHP)
+}
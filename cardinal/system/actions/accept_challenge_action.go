@@ -0,0 +1,99 @@
+// Package system contains the logic for handling battle actions.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * AcceptChallengeAction accepts a pending challenge and starts the battle.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Retrieve the battle and check it is pending, not expired, and challenges one of the persona's pets.
+ * 3. Check both pets are alive and idle.
+ * 4. Start the fight with each pet's Health as its battle health.
+ * 5. Start the "Battling" activity on both pets (see `component.StartPetActivity`), which outlasts the longest battle,
+ *    so they cannot do anything else until the battle ends.
+ * 6. Emit a `battle_started` event.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func AcceptChallengeAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(accept cardinal.TxData[msg.AcceptChallengeMsg]) (msg.AcceptChallengeMsgReply, error) {
+			// Step 2: Battle sanity check
+			battleID := accept.Msg.BattleID
+			battle, err := component.GetBattle(world, battleID)
+			if err != nil {
+				return msg.AcceptChallengeMsgReply{}, err
+			}
+			if battle.Opponent != accept.Tx.PersonaTag {
+				return msg.AcceptChallengeMsgReply{}, fmt.Errorf("failed to accept challenge [battle %d does not challenge your pets]", battleID)
+			}
+			if battle.Status != component.BattlePending {
+				return msg.AcceptChallengeMsgReply{}, fmt.Errorf("failed to accept challenge [battle %d is %s]", battleID, battle.Status)
+			}
+			if world.CurrentTick() >= battle.ExpiresTick {
+				return msg.AcceptChallengeMsgReply{}, fmt.Errorf("failed to accept challenge [battle %d expired at tick %d]", battleID, battle.ExpiresTick)
+			}
+
+			// Step 3: Check both pets are alive and idle
+			pets := []types.EntityID{battle.ChallengerPet, battle.OpponentPet}
+			hp := make([]int, len(pets))
+			for i, petId := range pets {
+				if err := system.CheckPetAlive(world, petId); err != nil {
+					return msg.AcceptChallengeMsgReply{}, err
+				}
+				if err := system.CheckPetActivity(world, petId); err != nil {
+					return msg.AcceptChallengeMsgReply{}, fmt.Errorf("pet is already engaged in an activity")
+				}
+				health, err := cardinal.GetComponent[component.Health](world, petId)
+				if err != nil {
+					return msg.AcceptChallengeMsgReply{}, fmt.Errorf("failed to accept challenge [get Health]: %w", err)
+				}
+				hp[i] = health.HP
+			}
+
+			// Step 4: Start the fight
+			battle.Status = component.BattleFighting
+			battle.StartedTick = world.CurrentTick()
+			battle.ChallengerHP = hp[0]
+			battle.OpponentHP = hp[1]
+			if err := cardinal.SetComponent(world, battleID, battle); err != nil {
+				return msg.AcceptChallengeMsgReply{}, fmt.Errorf("failed to accept challenge [set Battle]: %w", err)
+			}
+
+			// Step 5: Keep both pets busy for the longest possible battle
+			for _, petId := range pets {
+				if _, err := component.StartPetActivity(world, petId, game.ActivityBattling); err != nil {
+					return msg.AcceptChallengeMsgReply{}, fmt.Errorf("failed to accept challenge: %w", err)
+				}
+			}
+			log.Info().Msgf("Battle: %d started", battleID)
+
+			// Step 6: Emit the `battle_started` event
+			if err := world.EmitEvent(map[string]any{
+				"event": "battle_started",
+				"id":    battleID,
+			}); err != nil {
+				return msg.AcceptChallengeMsgReply{}, err
+			}
+			return msg.AcceptChallengeMsgReply{Success: true}, nil
+		})
+}
@@ -0,0 +1,94 @@
+// Package system contains the logic for handling trade actions.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * AcceptTradeAction settles a trade, swapping the escrowed offer with the recipient's assets.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Retrieve the trade and check the persona is its recipient and the offer has not expired.
+ * 3. Check the offered pets are still alive.
+ * 4. Take the requested assets from the recipient, all or nothing.
+ * 5. Give the offer to the recipient and the request to the proposer, moving the pets to their new owners.
+ * 6. Remove the trade and emit a `trade_accepted` event.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func AcceptTradeAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(accept cardinal.TxData[msg.AcceptTradeMsg]) (msg.AcceptTradeMsgReply, error) {
+			// Step 2: Trade sanity check
+			tradeID := accept.Msg.TradeID
+			trade, err := component.GetTrade(world, tradeID)
+			if err != nil {
+				return msg.AcceptTradeMsgReply{}, err
+			}
+			if trade.Recipient != accept.Tx.PersonaTag {
+				return msg.AcceptTradeMsgReply{}, fmt.Errorf("failed to accept trade [trade %d is not offered to you]", tradeID)
+			}
+			if world.CurrentTick() >= trade.ExpiresTick {
+				return msg.AcceptTradeMsgReply{}, fmt.Errorf("failed to accept trade [trade %d expired at tick %d]", tradeID, trade.ExpiresTick)
+			}
+
+			// Step 3: Check the offered pets are alive
+			for _, petID := range trade.Offer.Pets {
+				if err := system.CheckPetAlive(world, petID); err != nil {
+					return msg.AcceptTradeMsgReply{}, fmt.Errorf("failed to accept trade: %w", err)
+				}
+			}
+
+			proposerID, err := component.FindPlayerByPersonaTag(world, trade.Proposer)
+			if err != nil {
+				return msg.AcceptTradeMsgReply{}, err
+			}
+			recipientID, err := component.FindPlayerByPersonaTag(world, trade.Recipient)
+			if err != nil {
+				return msg.AcceptTradeMsgReply{}, err
+			}
+
+			// Step 4: Take the request from the recipient
+			reference := fmt.Sprintf("trade %d", tradeID)
+			if err := component.TakeEscrow(world, recipientID, trade.Request, game.LedgerTrade, reference); err != nil {
+				return msg.AcceptTradeMsgReply{}, err
+			}
+
+			// Step 5: Swap both sides
+			if err := component.ReleaseEscrow(world, recipientID, trade.Offer, game.LedgerTrade, reference); err != nil {
+				return msg.AcceptTradeMsgReply{}, err
+			}
+			if err := component.ReleaseEscrow(world, proposerID, trade.Request, game.LedgerTrade, reference); err != nil {
+				return msg.AcceptTradeMsgReply{}, err
+			}
+
+			// Step 6: Remove the trade and emit the `trade_accepted` event
+			if err := cardinal.Remove(world, tradeID); err != nil {
+				return msg.AcceptTradeMsgReply{}, fmt.Errorf("failed to accept trade [remove Trade]: %w", err)
+			}
+			log.Info().Msgf("Trade: %s accepted trade %d from %s", trade.Recipient, tradeID, trade.Proposer)
+			if err := world.EmitEvent(map[string]any{
+				"event": "trade_accepted",
+				"id":    tradeID,
+			}); err != nil {
+				return msg.AcceptTradeMsgReply{}, err
+			}
+			return msg.AcceptTradeMsgReply{Success: true}, nil
+		})
+}
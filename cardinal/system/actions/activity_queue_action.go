@@ -0,0 +1,109 @@
+// Package system contains the logic for queuing pet activities.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * EnqueueActivityAction queues an action on a pet, started automatically once the pet is free.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Find the player's living pet (see `findOwnedPet`).
+ * 3. Check the action can be queued and the player owns its item (see `system.CheckQueuedActivity`).
+ * 4. Check the queue is not full (`game.MaxActivityQueue`), then add the action at its end.
+ * 5. Return a reply with the position of the action in the queue. An idle pet starts it on this tick
+ *    (see `ActivityDeclineSystem`).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func EnqueueActivityAction(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(
+		world,
+		func(enqueue cardinal.TxData[msg.EnqueueActivityMsg]) (msg.EnqueueActivityMsgReply, error) {
+			// Step 2: Find the pet
+			petId, err := findOwnedPet(world, enqueue.Tx.PersonaTag, enqueue.Msg.TargetNickname)
+			if err != nil {
+				return msg.EnqueueActivityMsgReply{}, err
+			}
+
+			// Step 3: Check the action
+			player, err := component.GetPlayerByPersonaTag(world, enqueue.Tx.PersonaTag)
+			if err != nil {
+				return msg.EnqueueActivityMsgReply{}, err
+			}
+			if err := system.CheckQueuedActivity(world, player, enqueue.Msg.Action, enqueue.Msg.ItemName); err != nil {
+				return msg.EnqueueActivityMsgReply{}, err
+			}
+
+			// Step 4: Add the action to the queue
+			queue, ok := component.GetPetQueue(world, petId)
+			if !ok {
+				queue = &component.ActivityQueue{}
+			}
+			if len(queue.Pending) >= game.MaxActivityQueue {
+				return msg.EnqueueActivityMsgReply{}, fmt.Errorf("failed to queue activity [queue is full, max %d actions]", game.MaxActivityQueue)
+			}
+			queue.Pending = append(queue.Pending, component.QueuedActivity{
+				Action:     enqueue.Msg.Action,
+				ItemName:   enqueue.Msg.ItemName,
+				QueuedTick: world.CurrentTick(),
+			})
+			if err := component.SetPetQueue(world, petId, queue); err != nil {
+				return msg.EnqueueActivityMsgReply{}, err
+			}
+
+			// Step 5: Reply with the position
+			return msg.EnqueueActivityMsgReply{Position: len(queue.Pending)}, nil
+		})
+}
+
+/**
+ * ClearQueueAction drops every action queued on a pet. The current activity of the pet goes on.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Find the player's living pet (see `findOwnedPet`).
+ * 3. Empty the pet's queue and return a reply with the number of actions dropped.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func ClearQueueAction(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(
+		world,
+		func(clearQueue cardinal.TxData[msg.ClearQueueMsg]) (msg.ClearQueueMsgReply, error) {
+			// Step 2: Find the pet
+			petId, err := findOwnedPet(world, clearQueue.Tx.PersonaTag, clearQueue.Msg.TargetNickname)
+			if err != nil {
+				return msg.ClearQueueMsgReply{}, err
+			}
+
+			// Step 3: Empty the queue
+			queue, ok := component.GetPetQueue(world, petId)
+			if !ok || len(queue.Pending) == 0 {
+				return msg.ClearQueueMsgReply{}, nil
+			}
+			cleared := len(queue.Pending)
+			queue.Pending = nil
+			if err := component.SetPetQueue(world, petId, queue); err != nil {
+				return msg.ClearQueueMsgReply{}, err
+			}
+			return msg.ClearQueueMsgReply{Cleared: cleared}, nil
+		})
+}
@@ -2,21 +2,26 @@
 package system
 
 import (
+	"errors"
+	"fmt"
+
 	"pkg.world.dev/world-engine/cardinal"
 
 	"tamagotchi/component"
+	"tamagotchi/game"
 	"tamagotchi/msg"
 )
 
 /**
  * Function Flow:
  * 1. Check if the player exists and is valid.
- * 2. Check if the item to be bought exists.
- * 3. Get the player's and item's data.
- * 4. Check if the player has enough balance to buy the item.
- * 5. Reduce the player's balance by the item's price.
- * 6. Add the item to the player's inventory.
- * 7. Return a reply indicating the success of the buy action.
+ * 2. Check if the item to be bought exists and is still sold by a store (see `component.FindStoreForItem`),
+ *    and the quantity is valid (1 when omitted, at most `game.MaxStackQuantity`).
+ * 3. Get the player's and item's data, and check the player's stack of the item stays within `game.MaxStackQuantity`.
+ * 4. Check the total price does not overflow and the player has enough balance to buy the items.
+ * 5. Reduce the player's balance by the item's price times the quantity, recorded as a purchase in the ledger.
+ * 6. Add the items to the player's inventory stack, refunding the player if that fails.
+ * 7. Return a reply with the quantity the player now owns.
  *
  * BuyItemAction handles the item buying action for a given player and item.
  *
@@ -28,7 +33,15 @@ func BuyItemAction(world cardinal.WorldContext) error {
 	return cardinal.EachMessage(
 		world,
 		func(buyItem cardinal.TxData[msg.ButItemMsg]) (msg.BuyItemMsgReply, error) {
-			log.Info().Msgf("buyItem: n[%s]", buyItem.Msg.Name)
+			log.Info().Msgf("buyItem: n[%s] q[%d]", buyItem.Msg.Name, buyItem.Msg.Quantity)
+
+			quantity := buyItem.Msg.Quantity
+			if quantity == 0 {
+				quantity = 1
+			}
+			if quantity < 0 || quantity > game.MaxStackQuantity {
+				return msg.BuyItemMsgReply{}, fmt.Errorf("error Buying, invalid quantity [%d, at most %d]", quantity, game.MaxStackQuantity)
+			}
 
 			// Player sanity check
 			playerID, err := component.FindPlayerByPersonaTag(world, buyItem.Tx.PersonaTag)
@@ -48,20 +61,48 @@ func BuyItemAction(world cardinal.WorldContext) error {
 				return msg.BuyItemMsgReply{}, err
 			}
 
+			// Store sanity check, items that left the catalog are no longer sold
+			if _, err := component.FindStoreForItem(world, itemId); err != nil {
+				return msg.BuyItemMsgReply{}, fmt.Errorf("error Buying %s: %w", item.ItemName, err)
+			}
+
+			// Stack sanity check
+			player, err := cardinal.GetComponent[component.Player](world, playerID)
+			if err != nil {
+				return msg.BuyItemMsgReply{}, err
+			}
+			if owned := player.ItemQuantity(itemId); owned+quantity > game.MaxStackQuantity {
+				return msg.BuyItemMsgReply{}, fmt.Errorf("error Buying, a stack holds at most %d %s [have %d, buying %d]",
+					game.MaxStackQuantity, item.ItemName, owned, quantity)
+			}
+
 			// Reduce player's balance
-			err = component.ReducePlayerMoney(world, playerID, item.Price)
+			total, err := item.Price.Times(quantity)
+			if err != nil {
+				return msg.BuyItemMsgReply{}, fmt.Errorf("error Buying %s: %w", item.ItemName, err)
+			}
+			err = component.ReducePlayerMoney(world, playerID, total, game.LedgerPurchase, item.ItemName)
+			if err != nil {
+				return msg.BuyItemMsgReply{}, err
+			}
+
+			// Buy items, refunding the player if they cannot be added
+			err = component.AddPlayerItems(world, playerID, itemId, quantity)
 			if err != nil {
+				if refundErr := component.IncreasePlayerMoney(world, playerID, total, game.LedgerRefund, item.ItemName); refundErr != nil {
+					return msg.BuyItemMsgReply{}, errors.Join(err, refundErr)
+				}
 				return msg.BuyItemMsgReply{}, err
 			}
 
-			// Buy item
-			err = component.AddPlayerItem(world, playerID, itemId)
+			player, err = cardinal.GetComponent[component.Player](world, playerID)
 			if err != nil {
 				return msg.BuyItemMsgReply{}, err
 			}
 
 			return msg.BuyItemMsgReply{
-				Success: true,
+				Success:  true,
+				Quantity: player.ItemQuantity(itemId),
 			}, nil
 		},
 	)
@@ -0,0 +1,108 @@
+// Package system contains the logic for handling marketplace actions.
+package system
+
+import (
+	"errors"
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * BuyListingAction buys a marketplace listing.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Retrieve the listing and check it has not expired and the buyer is not the seller.
+ * 3. Check a listed pet is still alive.
+ * 4. Charge the buyer the listing price.
+ * 5. Release the goods to the buyer, moving a pet to the buyer's persona, and refund the buyer if they cannot be released.
+ * 6. Pay the seller the price minus `game.MarketFeePercent`. If the seller cannot be paid, take the goods back
+ *    into escrow and refund the buyer, leaving the listing open as it was.
+ * 7. Remove the listing and emit a `listing_sold` event.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func BuyListingAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(buy cardinal.TxData[msg.BuyListingMsg]) (msg.BuyListingMsgReply, error) {
+			// Step 2: Listing sanity check
+			listingID := buy.Msg.ListingID
+			listing, err := component.GetListing(world, listingID)
+			if err != nil {
+				return msg.BuyListingMsgReply{}, err
+			}
+			if listing.Seller == buy.Tx.PersonaTag {
+				return msg.BuyListingMsgReply{}, fmt.Errorf("failed to buy listing [cannot buy your own listing]")
+			}
+			if world.CurrentTick() >= listing.ExpiresTick {
+				return msg.BuyListingMsgReply{}, fmt.Errorf("failed to buy listing [listing %d expired at tick %d]", listingID, listing.ExpiresTick)
+			}
+
+			// Step 3: Check a listed pet is alive
+			for _, petID := range listing.Goods.Pets {
+				if err := system.CheckPetAlive(world, petID); err != nil {
+					return msg.BuyListingMsgReply{}, fmt.Errorf("failed to buy listing: %w", err)
+				}
+			}
+
+			buyerID, err := component.FindPlayerByPersonaTag(world, buy.Tx.PersonaTag)
+			if err != nil {
+				return msg.BuyListingMsgReply{}, err
+			}
+			sellerID, err := component.FindPlayerByPersonaTag(world, listing.Seller)
+			if err != nil {
+				return msg.BuyListingMsgReply{}, err
+			}
+
+			// Step 4: Charge the buyer
+			reference := fmt.Sprintf("listing %d", listingID)
+			if err := component.ReducePlayerMoney(world, buyerID, listing.Price, game.LedgerPurchase, reference); err != nil {
+				return msg.BuyListingMsgReply{}, err
+			}
+
+			// Step 5: Release the goods to the buyer, refunding the buyer if they cannot be released
+			if err := component.ReleaseEscrow(world, buyerID, listing.Goods, game.LedgerPurchase, reference); err != nil {
+				if refundErr := component.IncreasePlayerMoney(world, buyerID, listing.Price, game.LedgerRefund, reference); refundErr != nil {
+					return msg.BuyListingMsgReply{}, errors.Join(err, refundErr)
+				}
+				return msg.BuyListingMsgReply{}, err
+			}
+
+			// Step 6: Pay the seller, keeping the market fee. If the seller cannot be paid, undo the sale
+			if err := component.IncreasePlayerMoney(world, sellerID, listing.SellerProceeds(), game.LedgerSale, reference); err != nil {
+				if undoErr := component.TakeEscrow(world, buyerID, listing.Goods, game.LedgerRefund, reference); undoErr != nil {
+					return msg.BuyListingMsgReply{}, errors.Join(err, undoErr)
+				}
+				if refundErr := component.IncreasePlayerMoney(world, buyerID, listing.Price, game.LedgerRefund, reference); refundErr != nil {
+					return msg.BuyListingMsgReply{}, errors.Join(err, refundErr)
+				}
+				return msg.BuyListingMsgReply{}, err
+			}
+
+			// Step 7: Remove the listing and emit the `listing_sold` event
+			if err := cardinal.Remove(world, listingID); err != nil {
+				return msg.BuyListingMsgReply{}, fmt.Errorf("failed to buy listing [remove Listing]: %w", err)
+			}
+			log.Info().Msgf("Market: %s bought %d %s from %s for %s", buy.Tx.PersonaTag, listing.Quantity, listing.Label, listing.Seller, listing.Price)
+			if err := world.EmitEvent(map[string]any{
+				"event": "listing_sold",
+				"id":    listingID,
+			}); err != nil {
+				return msg.BuyListingMsgReply{}, err
+			}
+			return msg.BuyListingMsgReply{Success: true, Paid: listing.Price}, nil
+		})
+}
@@ -0,0 +1,118 @@
+// Package system contains the logic for stopping pet activities.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * CancelActivityAction stops the current activity of a pet before its end.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Find the player and their living pet, which must be engaged in an activity that can be cancelled
+ *    (see `game.ActivityKinds`).
+ * 3. Apply the completion effects of the activity and the rewards held back by the action starting it, e.g. the
+ *    effects of the food eaten, prorated to the ticks it ran (see `component.ActivityRewards`), then its cancel
+ *    penalty, e.g. a pet woken up is grumpy. The experience of the activity is prorated the same way.
+ * 4. Set the pet back to no activity, and let the pet think about it for a while (see `game.EventThoughts`).
+ * 5. Emit an `activity_cancelled` event and return a reply with the actual change of every stat and the experience earned.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func CancelActivityAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(cancel cardinal.TxData[msg.CancelActivityMsg]) (msg.CancelActivityMsgReply, error) {
+			// Step 2: Find the player and their pet
+			playerID, err := component.FindPlayerByPersonaTag(world, cancel.Tx.PersonaTag)
+			if err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+			player, err := cardinal.GetComponent[component.Player](world, playerID)
+			if err != nil {
+				return msg.CancelActivityMsgReply{}, fmt.Errorf("failed to cancel activity [get Player]: %w", err)
+			}
+			petId, err := player.GetPetNickname(world, cancel.Msg.TargetNickname)
+			if err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+			if err := system.CheckPetAlive(world, petId); err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+			pet, err := cardinal.GetComponent[component.Pet](world, petId)
+			if err != nil {
+				return msg.CancelActivityMsgReply{}, fmt.Errorf("failed to cancel activity [get Pet]: %w", err)
+			}
+
+			//   - Check the activity can be cancelled
+			activity, err := component.GetPetActivity(world, petId)
+			if err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+			if activity.Activity == game.InitialActivity || activity.CountDown <= 0 {
+				return msg.CancelActivityMsgReply{}, fmt.Errorf("failed to cancel activity [pet is not engaged in an activity]")
+			}
+			kind, ok := game.ActivityByName(activity.Activity)
+			if !ok || !kind.Cancelable {
+				return msg.CancelActivityMsgReply{}, fmt.Errorf("failed to cancel activity [%s cannot be cancelled]", activity.Activity)
+			}
+
+			// Step 3: Apply the prorated rewards and the penalty
+			elapsed := activity.TotalTicks - activity.CountDown
+			effects, xp := component.ActivityRewards(activity, kind, elapsed)
+			effects = append(effects, kind.CancelEffects...)
+			deltas, err := component.ApplyPetEffects(world, petId, kind.Name, effects)
+			if err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+			if err := component.GrantActivityXP(world, petId, kind.Name, xp); err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+
+			// Step 4: Stop the activity
+			if err := component.StopPetActivity(world, petId, activity); err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+			if err := component.NotePetEvent(world, petId, game.ActivityEvent(game.EventCancelled, kind.Name)); err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+			log.Info().Msgf("Activity: %s stopped %s of %s after %d ticks", cancel.Tx.PersonaTag, kind.Name, pet.Nickname, elapsed)
+
+			// Step 5: Emit the `activity_cancelled` event
+			if err := world.EmitEvent(map[string]any{
+				"event":    "activity_cancelled",
+				"id":       petId,
+				"nickname": pet.Nickname,
+				"activity": kind.Name,
+				"elapsed":  elapsed,
+				"effects":  deltas,
+				"xp":       xp,
+			}); err != nil {
+				return msg.CancelActivityMsgReply{}, err
+			}
+			return msg.CancelActivityMsgReply{
+				Activity: kind.Name,
+				Elapsed:  elapsed,
+				Health:   deltas.Health,
+				Energy:   deltas.Energy,
+				Hygiene:  deltas.Hygiene,
+				Wellness: deltas.Wellness,
+				Satiety:  deltas.Satiety,
+				XP:       xp,
+			}, nil
+		})
+}
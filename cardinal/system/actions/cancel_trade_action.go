@@ -0,0 +1,60 @@
+// Package system contains the logic for handling trade actions.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+/**
+ * CancelTradeAction calls off a pending trade and returns the escrowed offer to the proposer.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Retrieve the trade and check the persona is its proposer or recipient.
+ * 3. Return the offer to the proposer and remove the trade.
+ * 4. Emit a `trade_cancelled` event.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func CancelTradeAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(cancel cardinal.TxData[msg.CancelTradeMsg]) (msg.CancelTradeMsgReply, error) {
+			// Step 2: Trade sanity check
+			tradeID := cancel.Msg.TradeID
+			trade, err := component.GetTrade(world, tradeID)
+			if err != nil {
+				return msg.CancelTradeMsgReply{}, err
+			}
+			if !trade.Involves(cancel.Tx.PersonaTag) {
+				return msg.CancelTradeMsgReply{}, fmt.Errorf("failed to cancel trade [you are not part of trade %d]", tradeID)
+			}
+
+			// Step 3: Return the offer and remove the trade
+			if err := component.CloseTrade(world, tradeID, trade, game.LedgerRefund); err != nil {
+				return msg.CancelTradeMsgReply{}, err
+			}
+			log.Info().Msgf("Trade: %s cancelled trade %d", cancel.Tx.PersonaTag, tradeID)
+
+			// Step 4: Emit the `trade_cancelled` event
+			if err := world.EmitEvent(map[string]any{
+				"event": "trade_cancelled",
+				"id":    tradeID,
+			}); err != nil {
+				return msg.CancelTradeMsgReply{}, err
+			}
+			return msg.CancelTradeMsgReply{Success: true}, nil
+		})
+}
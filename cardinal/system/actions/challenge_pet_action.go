@@ -0,0 +1,118 @@
+// Package system contains the logic for handling battle actions.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * ChallengePetAction challenges another persona's pet to a battle.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Retrieve the challenger's pet, which must belong to the persona, and the opponent pet, which must not.
+ * 3. Check both pets can battle (see `CheckPetCanBattle`).
+ * 4. Create a pending battle, dropped after `game.ChallengeExpiryTicks` if not accepted.
+ * 5. Emit a `battle_challenged` event and reply with the battle ID.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func ChallengePetAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(challenge cardinal.TxData[msg.ChallengePetMsg]) (msg.ChallengePetMsgReply, error) {
+			// Step 2: Pets sanity check
+			playerID, err := component.FindPlayerByPersonaTag(world, challenge.Tx.PersonaTag)
+			if err != nil {
+				return msg.ChallengePetMsgReply{}, err
+			}
+			player, err := cardinal.GetComponent[component.Player](world, playerID)
+			if err != nil {
+				return msg.ChallengePetMsgReply{}, fmt.Errorf("failed to challenge [get Player]: %w", err)
+			}
+			petId, err := player.GetPetNickname(world, challenge.Msg.PetNickname)
+			if err != nil {
+				return msg.ChallengePetMsgReply{}, err
+			}
+			opponentId, opponent, err := component.GetPetByNickname(world, challenge.Msg.OpponentNickname)
+			if err != nil {
+				return msg.ChallengePetMsgReply{}, err
+			}
+			if opponent.PersonaTag == challenge.Tx.PersonaTag {
+				return msg.ChallengePetMsgReply{}, fmt.Errorf("failed to challenge [cannot challenge your own pet]")
+			}
+
+			// Step 3: Check both pets can battle
+			for _, id := range []types.EntityID{petId, opponentId} {
+				if err := CheckPetCanBattle(world, id); err != nil {
+					return msg.ChallengePetMsgReply{}, err
+				}
+			}
+
+			// Step 4: Create the pending battle
+			battle := component.Battle{
+				Challenger:    challenge.Tx.PersonaTag,
+				Opponent:      opponent.PersonaTag,
+				ChallengerPet: petId,
+				OpponentPet:   opponentId,
+				Status:        component.BattlePending,
+				CreatedTick:   world.CurrentTick(),
+				ExpiresTick:   world.CurrentTick() + game.ChallengeExpiryTicks,
+			}
+			battleID, err := cardinal.Create(world, battle, component.BattleLog{Rounds: make([]component.BattleRound, 0)})
+			if err != nil {
+				return msg.ChallengePetMsgReply{}, fmt.Errorf("failed to challenge [create Battle]: %w", err)
+			}
+			log.Info().Msgf("Battle: %s challenged %s", challenge.Msg.PetNickname, opponent.Nickname)
+
+			// Step 5: Emit the `battle_challenged` event
+			if err := world.EmitEvent(map[string]any{
+				"event": "battle_challenged",
+				"id":    battleID,
+			}); err != nil {
+				return msg.ChallengePetMsgReply{}, err
+			}
+			return msg.ChallengePetMsgReply{BattleID: battleID, ExpiresTick: battle.ExpiresTick}, nil
+		})
+}
+
+/**
+ * CheckPetCanBattle checks the pet is alive, idle and not already in a battle.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   error: An error if the pet cannot battle.
+ */
+func CheckPetCanBattle(world cardinal.WorldContext, petId types.EntityID) error {
+	if err := system.CheckPetAlive(world, petId); err != nil {
+		return err
+	}
+	activity, err := cardinal.GetComponent[component.Activity](world, petId)
+	if err != nil {
+		return fmt.Errorf("failed to battle [get Activity]: %w", err)
+	}
+	if activity.CountDown > 0 {
+		return fmt.Errorf("pet is already engaged in an activity")
+	}
+	if component.IsPetBattling(world, petId) {
+		return fmt.Errorf("pet is already in a battle")
+	}
+	return nil
+}
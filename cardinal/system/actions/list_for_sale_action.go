@@ -0,0 +1,126 @@
+// Package system contains the logic for handling marketplace actions.
+package system
+
+import (
+	"errors"
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * ListForSaleAction puts a stack of owned items or an owned pet up for sale on the marketplace.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Check the seller exists and the price is positive.
+ * 3. Resolve the goods: either an item and quantity (1 when omitted), or a living pet of the seller that is not busy.
+ *    A listed pet is held in escrow with its stats frozen until it is bought or the listing expires.
+ * 4. Create the listing entity, expiring after `game.ListingExpiryTicks`.
+ * 5. Take the goods from the seller into escrow, removing the listing again if that fails.
+ * 6. Emit a `listing_created` event and reply with the listing ID.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func ListForSaleAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(list cardinal.TxData[msg.ListForSaleMsg]) (msg.ListForSaleMsgReply, error) {
+			// Step 2: Seller and price sanity check
+			sellerID, err := component.FindPlayerByPersonaTag(world, list.Tx.PersonaTag)
+			if err != nil {
+				return msg.ListForSaleMsgReply{}, err
+			}
+			if list.Msg.Price <= 0 {
+				return msg.ListForSaleMsgReply{}, fmt.Errorf("failed to list [invalid price %s]", list.Msg.Price)
+			}
+
+			// Step 3: Resolve the goods
+			listing := component.Listing{
+				Seller:      list.Tx.PersonaTag,
+				Price:       list.Msg.Price,
+				CreatedTick: world.CurrentTick(),
+				ExpiresTick: world.CurrentTick() + game.ListingExpiryTicks,
+			}
+			switch {
+			case list.Msg.ItemName != "" && list.Msg.PetName != "":
+				return msg.ListForSaleMsgReply{}, fmt.Errorf("failed to list [list either an item or a pet]")
+			case list.Msg.ItemName != "":
+				quantity := list.Msg.Quantity
+				if quantity == 0 {
+					quantity = 1
+				}
+				if quantity < 0 {
+					return msg.ListForSaleMsgReply{}, fmt.Errorf("failed to list [invalid quantity %d]", quantity)
+				}
+				itemId, err := component.FindItemByName(world, list.Msg.ItemName)
+				if err != nil {
+					return msg.ListForSaleMsgReply{}, err
+				}
+				item, err := cardinal.GetComponent[component.Item](world, itemId)
+				if err != nil {
+					return msg.ListForSaleMsgReply{}, fmt.Errorf("failed to list [get Item]: %w", err)
+				}
+				listing.Goods.Items = []component.ItemStack{{ItemID: itemId, Quantity: quantity}}
+				listing.Kind = item.Kind
+				listing.Label = item.ItemName
+				listing.Quantity = quantity
+			case list.Msg.PetName != "":
+				petId, pet, err := component.GetPetByNickname(world, list.Msg.PetName)
+				if err != nil {
+					return msg.ListForSaleMsgReply{}, err
+				}
+				if pet.PersonaTag != list.Tx.PersonaTag {
+					return msg.ListForSaleMsgReply{}, fmt.Errorf("failed to list [You are not the owner of %s]", pet.Nickname)
+				}
+				if err := system.CheckPetAlive(world, petId); err != nil {
+					return msg.ListForSaleMsgReply{}, err
+				}
+				if err := system.CheckPetActivity(world, petId); err != nil {
+					return msg.ListForSaleMsgReply{}, err
+				}
+				listing.Goods.Pets = []types.EntityID{petId}
+				listing.Kind = component.ListingKindPet
+				listing.Label = pet.Nickname
+				listing.Quantity = 1
+			default:
+				return msg.ListForSaleMsgReply{}, fmt.Errorf("failed to list [nothing to sell]")
+			}
+
+			// Step 4: Create the listing
+			listingID, err := cardinal.Create(world, listing)
+			if err != nil {
+				return msg.ListForSaleMsgReply{}, fmt.Errorf("failed to list [create Listing]: %w", err)
+			}
+
+			// Step 5: Hold the goods in escrow
+			if err := component.TakeEscrow(world, sellerID, listing.Goods, game.LedgerSale, fmt.Sprintf("listing %d", listingID)); err != nil {
+				if removeErr := cardinal.Remove(world, listingID); removeErr != nil {
+					return msg.ListForSaleMsgReply{}, errors.Join(err, removeErr)
+				}
+				return msg.ListForSaleMsgReply{}, err
+			}
+			log.Info().Msgf("Market: %s listed %d %s for %s", listing.Seller, listing.Quantity, listing.Label, listing.Price)
+
+			// Step 6: Emit the `listing_created` event
+			if err := world.EmitEvent(map[string]any{
+				"event": "listing_created",
+				"id":    listingID,
+			}); err != nil {
+				return msg.ListForSaleMsgReply{}, err
+			}
+			return msg.ListForSaleMsgReply{ListingID: listingID, ExpiresTick: listing.ExpiresTick}, nil
+		})
+}
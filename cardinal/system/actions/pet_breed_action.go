@@ -5,10 +5,12 @@ import (
 	"fmt"
 
 	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
 
 	"tamagotchi/component"
 	"tamagotchi/game"
 	"tamagotchi/msg"
+	"tamagotchi/system"
 )
 
 /**
@@ -20,9 +22,14 @@ PetBreedAction Function Flow:
    - Get the father and mother pets.
    - Check if the mother and father are of different genders.
    - Check if the persona is the owner of the mother and father pets.
-4. Create a new pet entity with initial characteristics:
-   - Generate random element and skill.
-   - Create a new entity with Pet, Health, Energy, Hygiene, Wellness, Dna, Activity, Think, Magic, and Skill components.
+   - Check if the mother and father are alive.
+   - Check if the mother and father are old enough to breed (see `game.StageProperties.CanBreed`).
+   - Check if the mother and father are not held in a trade escrow.
+4. Create a new pet entity with inherited characteristics:
+   - Derive the child Dna from the mother and father Dna (see `component.InheritDna`), and its personality from its Dna.
+   - Pick element and skill biased by the parents' Magic and Skill kinds.
+   - Create a new entity with Pet, Health, Energy, Hygiene, Wellness, Hunger, Dna, Personality, Mood, Activity, Think, Magic, Skill, Lineage and LifeStage components.
+   - Add the child to the owner's pets.
 5. Emit a 'new_pet' event with the new pet's ID.
 */
 // PetBreedAction spawns pets based on `Create-pet` transactions.
@@ -36,17 +43,21 @@ func PetBreedAction(world cardinal.WorldContext) error {
 		func(create cardinal.TxData[msg.BreedPetMsg]) (msg.BreedPetMsgReply, error) {
 			// 3. Perform sanity checks:
 			//    - Check if the pet name already exists.
-			if _, _, err := component.QueryPetIdByName(world, create.Msg.BornName); err != nil {
+			found, _, err := component.QueryPetIdByName(world, create.Msg.BornName)
+			if err != nil {
 				return msg.BreedPetMsgReply{}, err
 			}
+			if found {
+				return msg.BreedPetMsgReply{}, fmt.Errorf("error creating pet: Name already exist")
+			}
 
 			//    - Get the father and mother pets.
-			_, father, err := component.GetPetByNickname(world, create.Msg.FatherName)
+			fatherId, father, err := component.GetPetByNickname(world, create.Msg.FatherName)
 			if err != nil {
 				return msg.BreedPetMsgReply{}, err
 			}
 
-			_, mother, err := component.GetPetByNickname(world, create.Msg.MotherName)
+			motherId, mother, err := component.GetPetByNickname(world, create.Msg.MotherName)
 			if err != nil {
 				return msg.BreedPetMsgReply{}, err
 			}
@@ -63,33 +74,87 @@ func PetBreedAction(world cardinal.WorldContext) error {
 				return msg.BreedPetMsgReply{}, err
 			}
 
-			// 4. Create a new pet entity with initial characteristics:
-			//    - Generate random element and skill.
-			element := game.Elements[rng.Intn(len(game.Elements))]
-			skill := game.Skills[rng.Intn(len(game.Skills))]
+			//    - Check if the mother and father are alive.
+			for _, parentId := range []types.EntityID{fatherId, motherId} {
+				if err := system.CheckPetAlive(world, parentId); err != nil {
+					return msg.BreedPetMsgReply{}, err
+				}
+			}
+
+			//    - Check if the mother and father are old enough to breed.
+			if err := CheckPetsCanBreed(world, fatherId, motherId); err != nil {
+				return msg.BreedPetMsgReply{}, err
+			}
+
+			playerID, err := component.FindPlayerByPersonaTag(world, create.Tx.PersonaTag)
+			if err != nil {
+				return msg.BreedPetMsgReply{}, err
+			}
+
+			//    - Check the mother and father are not held in a trade escrow.
+			player, err := cardinal.GetComponent[component.Player](world, playerID)
+			if err != nil {
+				return msg.BreedPetMsgReply{}, fmt.Errorf("error creating pet [get Player]: %w", err)
+			}
+			if !player.HasPet(fatherId) || !player.HasPet(motherId) {
+				return msg.BreedPetMsgReply{}, fmt.Errorf("error creating pet [parents held in a trade cannot breed]")
+			}
+
+			// 4. Create a new pet entity with inherited characteristics:
+			//    - Derive the child Dna from the mother and father Dna.
+			fatherDna, err := cardinal.GetComponent[component.Dna](world, fatherId)
+			if err != nil {
+				return msg.BreedPetMsgReply{}, fmt.Errorf("error creating pet [get father Dna]: %w", err)
+			}
+			motherDna, err := cardinal.GetComponent[component.Dna](world, motherId)
+			if err != nil {
+				return msg.BreedPetMsgReply{}, fmt.Errorf("error creating pet [get mother Dna]: %w", err)
+			}
+			dna, genes := component.InheritDna(rng, fatherDna, motherDna)
+
+			//    - Pick element and skill biased by the parents' kinds.
+			fatherMagic, motherMagic := parentMagicKinds(world, fatherId, motherId)
+			fatherSkill, motherSkill := parentSkillKinds(world, fatherId, motherId)
+			element := component.InheritKind(rng, fatherMagic, motherMagic, game.Elements)
+			skill := component.InheritKind(rng, fatherSkill, motherSkill, game.Skills)
 
-			//    - Create a new entity with Pet, Health, Energy, Hygiene, Wellness, Dna, Activity, Think, Magic, and Skill components.
+			//    - Create a new entity with Pet, Health, Energy, Hygiene, Wellness, Hunger, Dna, Personality, Mood, Activity, Think, Magic, Skill, Lineage and LifeStage components.
 			id, err := cardinal.Create(world,
-				component.Pet{PersonaTag: create.Tx.PersonaTag, Nickname: create.Msg.BornName, Level: 0, XP: 0, NextLevelXP: 0},
+				component.Pet{PersonaTag: create.Tx.PersonaTag, Nickname: create.Msg.BornName, Level: 0, XP: 0, NextLevelXP: 0, Gender: rng.Intn(2) > 0, BornTick: world.CurrentTick()},
 				component.Health{HP: game.MaxHP},
 				component.Energy{E: game.MaxEnergy},
 				component.Hygiene{Hy: game.MaxHygiene},
 				component.Wellness{Wn: game.MaxWellness},
-				component.Dna{ // use Mother and father DNA here
-					A: rng.Intn(100),
-					C: rng.Intn(100),
-					G: rng.Intn(100),
-					T: rng.Intn(100),
-				},
+				component.Hunger{Satiety: game.MaxSatiety},
+				dna,
+				component.NewPersonality(dna),
+				component.NewMood(game.MaxMoodScore, world.CurrentTick()),
 				component.Activity{Activity: "None", CountDown: 0},
 				component.Think{Think: "..."},
-				component.Magic{Kind: element, Level: 0, XP: 0, NextLevelXP: 0},
-				component.Skill{Kind: skill, Level: 0, XP: 0, NextLevelXP: 0},
+				component.Thoughts{History: make([]component.ThoughtEntry, 0)},
+				component.NewMagic(element),
+				component.NewSkill(skill),
+				component.Lineage{
+					FatherID: fatherId,
+					MotherID: motherId,
+					Father:   father.Nickname,
+					Mother:   mother.Nickname,
+					Genes:    genes,
+				},
+				component.LifeStage{Stage: game.StageEgg, Since: world.CurrentTick()},
 			)
 			if err != nil {
 				return msg.BreedPetMsgReply{}, fmt.Errorf("error creating pet: %w", err)
 			}
 
+			//    - Add the child to the owner's pets and to the Index.
+			if err := component.AddPlayerPet(world, playerID, id); err != nil {
+				return msg.BreedPetMsgReply{}, err
+			}
+			if err := component.IndexPet(world, create.Msg.BornName, id); err != nil {
+				return msg.BreedPetMsgReply{}, err
+			}
+
 			// 5. Emit a 'new_pet' event with the new pet's ID.
 			err = world.EmitEvent(map[string]any{
 				"event": "new_pet",
@@ -136,3 +201,61 @@ func CheckOwnerOfPets(world cardinal.WorldContext, personaTag string, father *co
 	}
 	return nil
 }
+
+/**
+ * CheckPetsCanBreed checks that both parents are in a life stage that allows breeding.
+ *
+ * Code Flow:
+ * 1. Fetch the LifeStage component of each parent.
+ * 2. Return an error naming the first parent whose stage cannot breed.
+ */
+func CheckPetsCanBreed(world cardinal.WorldContext, fatherId types.EntityID, motherId types.EntityID) error {
+	parents := []struct {
+		role string
+		id   types.EntityID
+	}{{"father", fatherId}, {"mother", motherId}}
+	for _, parent := range parents {
+		stage, err := component.GetPetLifeStage(world, parent.id)
+		if err != nil {
+			return fmt.Errorf("error creating pet [get %s life stage]: %w", parent.role, err)
+		}
+		if !stage.Properties().CanBreed {
+			return fmt.Errorf("error creating pet [%s is a %s, pets can only breed as adults]", parent.role, stage.Stage)
+		}
+	}
+	return nil
+}
+
+/**
+ * parentMagicKinds returns the Magic kinds of both parents.
+ *
+ * Code Flow:
+ * 1. Fetch the Magic component of each parent.
+ * 2. Return the kinds, using an empty string for a parent without Magic (e.g. a founder pet).
+ */
+func parentMagicKinds(world cardinal.WorldContext, fatherId types.EntityID, motherId types.EntityID) (string, string) {
+	kinds := make([]string, 2)
+	for i, id := range []types.EntityID{fatherId, motherId} {
+		if magic, err := cardinal.GetComponent[component.Magic](world, id); err == nil {
+			kinds[i] = magic.Kind
+		}
+	}
+	return kinds[0], kinds[1]
+}
+
+/**
+ * parentSkillKinds returns the Skill kinds of both parents.
+ *
+ * Code Flow:
+ * 1. Fetch the Skill component of each parent.
+ * 2. Return the kinds, using an empty string for a parent without Skill (e.g. a founder pet).
+ */
+func parentSkillKinds(world cardinal.WorldContext, fatherId types.EntityID, motherId types.EntityID) (string, string) {
+	kinds := make([]string, 2)
+	for i, id := range []types.EntityID{fatherId, motherId} {
+		if skill, err := cardinal.GetComponent[component.Skill](world, id); err == nil {
+			kinds[i] = skill.Kind
+		}
+	}
+	return kinds[0], kinds[1]
+}
@@ -0,0 +1,119 @@
+// Package system contains the logic for handling pet revival actions.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+/**
+ * PetReviveAction brings a deceased pet back to life by consuming a rare care item.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Retrieve the player and the pet, and check the player owns the pet.
+ * 3. Check the pet is deceased.
+ * 4. Check the item is a revive item (it has a `game.EffectRevive` effect).
+ * 5. Remove the Deceased component, restore the pet's stats (including satiety) to the item's value and heal its disease.
+ * 6. Move the pet from the player's graveyard back to the player's pets.
+ * 7. Consume the item and emit a `pet_revived` event.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func PetReviveAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(revive cardinal.TxData[msg.RevivePetMsg]) (msg.RevivePetMsgReply, error) {
+			// Step 2: Player sanity check
+			playerID, err := component.FindPlayerByPersonaTag(world, revive.Tx.PersonaTag)
+			if err != nil {
+				return msg.RevivePetMsgReply{}, err
+			}
+			player, err := cardinal.GetComponent[component.Player](world, playerID)
+			if err != nil {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [get Player]: %w", err)
+			}
+
+			petId, pet, err := component.GetPetByNickname(world, revive.Msg.TargetNickname)
+			if err != nil {
+				return msg.RevivePetMsgReply{}, err
+			}
+			if pet.PersonaTag != revive.Tx.PersonaTag {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [You are not the owner of %s]", pet.Nickname)
+			}
+
+			// Step 3: Check the pet is deceased
+			if !component.IsPetDeceased(world, petId) {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [%s is alive]", pet.Nickname)
+			}
+
+			// Step 4: Check the item is a revive item
+			log.Info().Msgf("Revive: Item [%s]", revive.Msg.ItemName)
+			itemId, err := player.GetItemIdByName(world, revive.Msg.ItemName)
+			if err != nil {
+				return msg.RevivePetMsgReply{}, err
+			}
+			effects := component.GetItemEffects(world, itemId)
+			if !component.HasEffectOn(effects, game.EffectRevive) {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [%s cannot revive a pet]", revive.Msg.ItemName)
+			}
+			value := 0
+			for _, effect := range effects {
+				if effect.Stat == game.EffectRevive {
+					value += effect.Amount
+				}
+			}
+
+			// Step 5: Remove the Deceased component and restore the pet's stats
+			if err := cardinal.RemoveComponentFrom[component.Deceased](world, petId); err != nil {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [remove Deceased]: %w", err)
+			}
+			if err := cardinal.SetComponent(world, petId, &component.Health{HP: value}); err != nil {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [set Health]: %w", err)
+			}
+			if err := cardinal.SetComponent(world, petId, &component.Energy{E: value}); err != nil {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [set Energy]: %w", err)
+			}
+			if err := cardinal.SetComponent(world, petId, &component.Hygiene{Hy: value}); err != nil {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [set Hygiene]: %w", err)
+			}
+			if err := cardinal.SetComponent(world, petId, &component.Wellness{Wn: value}); err != nil {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [set Wellness]: %w", err)
+			}
+			if err := cardinal.SetComponent(world, petId, &component.Hunger{Satiety: value}); err != nil {
+				return msg.RevivePetMsgReply{}, fmt.Errorf("failed to revive [set Hunger]: %w", err)
+			}
+			if err := component.HealPet(world, petId); err != nil {
+				return msg.RevivePetMsgReply{}, err
+			}
+
+			// Step 6: Move the pet back from the graveyard
+			if err := component.UnburyPlayerPet(world, playerID, petId); err != nil {
+				return msg.RevivePetMsgReply{}, err
+			}
+
+			// Step 7: Consume the item and emit the `pet_revived` event
+			if err := component.RemoveItem(world, playerID, itemId); err != nil {
+				return msg.RevivePetMsgReply{}, err
+			}
+			if err := world.EmitEvent(map[string]any{
+				"event": "pet_revived",
+				"id":    petId,
+			}); err != nil {
+				return msg.RevivePetMsgReply{}, err
+			}
+
+			return msg.RevivePetMsgReply{Health: value}, nil
+		})
+}
@@ -8,24 +8,20 @@ import (
 	"pkg.world.dev/world-engine/cardinal/types"
 
 	"tamagotchi/component"
-	"tamagotchi/game"
 	"tamagotchi/msg"
 	"tamagotchi/system"
 )
 
 /**
- * PetSleepAction reduces the pet's energy and sets its activity to "Sleeping".
+ * PetSleepAction puts the pet to sleep, restoring its energy over the night.
  *
  * Code Flow:
  * 1. Process each incoming message using `cardinal.EachMessage`.
  * 2. Retrieve the pet's ID by its nickname using `QueryPetIdByName`.
- * 3. Check if the pet is not currently engaged in an activity using `CheckPetActivity`.
- * 4. Fetch the pet's think component using `GetPetThink`.
- * 5. Fetch the pet's energy component using `GetPetEnergy`.
- * 6. Increase the pet's energy points.
- * 7. Set the pet's think to "Sleeping".
- * 8. Set the pet's activity to "Sleeping" and initialize the countdown.
- * 9. Update the pet's components in the world context.
+ * 3. Check the pet is alive and not currently engaged in an activity, then start the "Sleeping" activity,
+ *    which sets the pet's think (see `system.StartSleep`).
+ *    Energy is restored while the pet sleeps and it wakes up well rested (see `game.ActivityKinds`).
+ * 4. Return a reply with the pet's energy as it falls asleep, the activity and its duration.
  *
  * Parameters:
  *   world (cardinal.WorldContext): The world context.
@@ -34,10 +30,6 @@ import (
  *   error: Any error that occurs during the process.
  */
 func PetSleepAction(world cardinal.WorldContext) error {
-	var petEnergy *component.Energy
-	var petActivity *component.Activity
-	var petThink *component.Think
-
 	return cardinal.EachMessage(
 		world,
 		func(Sleep cardinal.TxData[msg.SleepPetMsg]) (msg.SleepPetMsgReply, error) {
@@ -46,46 +38,19 @@ func PetSleepAction(world cardinal.WorldContext) error {
 				return msg.SleepPetMsgReply{}, err
 			}
 
-			if err := system.CheckPetActivity(world, petId); err != nil {
-				return msg.SleepPetMsgReply{}, err
-			}
-
-			petThink, err = component.GetPetThink(world, petId)
+			// Check the pet is alive and idle, then start sleeping.
+			petActivity, err := system.StartSleep(world, petId)
 			if err != nil {
 				return msg.SleepPetMsgReply{}, err
 			}
 
-			petThink.Think = game.ThinkSleep
-
-			// set activity
-			petActivity, err = component.GetPetActivity(world, petId)
+			petEnergy, err := component.GetPetEnergy(world, petId)
 			if err != nil {
 				return msg.SleepPetMsgReply{}, err
 			}
 
-			petActivity.Activity = "Sleeping"
-			petActivity.CountDown = game.TickEightHours
-			petActivity.TotalTicks = game.TickEightHours
-			petActivity.Percentage = 100
-
-			petEnergy, err = component.GetPetEnergy(world, petId)
-			if err != nil {
-				return msg.SleepPetMsgReply{}, err
-			}
-
-			// increase Energy
-			if petEnergy.E+game.EnergyIncrease <= 100 {
-				petEnergy.E += game.EnergyIncrease
-			} else {
-				petEnergy.E = 100
-			}
-
-			if err := UpdatePetComponents(world, petId, petEnergy, petActivity, petThink); err != nil {
-				return msg.SleepPetMsgReply{}, err
-			}
-
 			return msg.SleepPetMsgReply{
-				Energy:   game.EnergyReduce,
+				Energy:   petEnergy.E,
 				Activity: petActivity.Activity,
 				Duration: petActivity.CountDown}, nil
 		})
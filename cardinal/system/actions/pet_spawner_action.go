@@ -2,6 +2,7 @@
 package system
 
 import (
+	"errors"
 	"fmt"
 
 	"pkg.world.dev/world-engine/cardinal"
@@ -66,12 +67,16 @@ func PetSpawnerAction(world cardinal.WorldContext) error {
 			// Step 5: Create a new pet and assign it to the player
 			//   - Use the `CreateRandomPet` function to create a new pet
 			//   - Add the pet to the player's pets
-			petID, err := component.CreateRandomPet(world, create.Tx.PersonaTag, create.Msg.Nickname)
-			if err != nil {
+			//   - Charge the pet cost first, refunding it if the pet cannot be created
+			if err := component.ReducePlayerMoney(world, playerID, game.PetCost, game.LedgerPetCreation, create.Msg.Nickname); err != nil {
 				return msg.CreatePetReply{}, err
 			}
 
-			if err := component.ReducePlayerMoney(world, playerID, game.PetCost); err != nil {
+			petID, err := component.CreateRandomPet(world, create.Tx.PersonaTag, create.Msg.Nickname)
+			if err != nil {
+				if refundErr := component.IncreasePlayerMoney(world, playerID, game.PetCost, game.LedgerRefund, create.Msg.Nickname); refundErr != nil {
+					return msg.CreatePetReply{}, errors.Join(err, refundErr)
+				}
 				return msg.CreatePetReply{}, err
 			}
 
@@ -0,0 +1,78 @@
+// Package system contains the logic for asking a pet what it thinks.
+package system
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * PetThinkAction lets an owner ask their pet what it thinks.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Find the player's living pet (see `findOwnedPet`) and read its current thought.
+ * 3. Find the most pressing need of the pet (see `system.PetNeed`) and the store item raising that stat the most.
+ * 4. Give the pet `game.ThinkPetWellness` wellness for the attention, at most once every `game.ThinkPetCooldown` ticks.
+ * 5. Return a reply with the thought, the need and the recommended item.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func PetThinkAction(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(
+		world,
+		func(think cardinal.TxData[msg.ThinkPetMsg]) (msg.ThinkPetMsgReply, error) {
+			// Step 2: Find the pet and read its thought
+			petId, err := findOwnedPet(world, think.Tx.PersonaTag, think.Msg.TargetNickname)
+			if err != nil {
+				return msg.ThinkPetMsgReply{}, err
+			}
+			petThink, err := component.GetPetThink(world, petId)
+			if err != nil {
+				return msg.ThinkPetMsgReply{}, err
+			}
+			reply := msg.ThinkPetMsgReply{Think: petThink.Think}
+
+			// Step 3: Find the need and the recommended item
+			need, err := system.PetNeed(world, petId)
+			if err != nil {
+				return msg.ThinkPetMsgReply{}, err
+			}
+			if need != nil {
+				reply.Need, reply.Value, reply.Explanation = need.Stat, need.Value, need.Explanation
+				catalog, err := game.Catalog()
+				if err != nil {
+					return msg.ThinkPetMsgReply{}, err
+				}
+				if item, store, ok := catalog.BestItemFor(need.Stat); ok {
+					reply.Item, reply.Store = item.Name, store
+				}
+			}
+
+			// Step 4: Give the wellness bonus
+			bonus, next, err := component.TakeAskBonus(world, petId, game.ThinkPetCooldown)
+			if err != nil {
+				return msg.ThinkPetMsgReply{}, err
+			}
+			reply.NextBonusTick = next
+			if bonus {
+				deltas, err := component.ApplyPetEffects(world, petId, "think-pet",
+					[]game.ItemEffect{{Stat: game.StatWellness, Amount: game.ThinkPetWellness}})
+				if err != nil {
+					return msg.ThinkPetMsgReply{}, err
+				}
+				reply.Wellness = deltas.Wellness
+			}
+
+			// Step 5: Reply with the thought
+			return reply, nil
+		})
+}
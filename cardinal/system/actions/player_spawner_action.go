@@ -60,18 +60,23 @@ func PlayerSpawnerAction(world cardinal.WorldContext) error {
 			// Step 3: Create a new player entity
 			//   - Use the `Create` function to create a new player entity with the provided persona tag
 			//   - Initialize the player's properties, such as pets, items, and money
+			//   - Add the player to the Index, so it can be found by persona tag
 			id, err := cardinal.Create(world,
 				component.Player{
 					PersonaTag: create.Tx.PersonaTag,
 					Pets:       make([]types.EntityID, 0),
-					Items:      make([]types.EntityID, 0),
+					Inventory:  make([]component.ItemStack, 0),
 					Money:      game.PlayerInitialMoney,
 				},
+				component.Ledger{Entries: make([]component.LedgerEntry, 0)},
 			)
 			if err != nil {
 				// Error creating player, return an error
 				return msg.CreatePlayerReply{}, fmt.Errorf("error creating player: %w", err)
 			}
+			if err := component.IndexPlayer(world, create.Tx.PersonaTag, id); err != nil {
+				return msg.CreatePlayerReply{}, err
+			}
 
 			// Step 4: Emit a "new_player" event
 			//   - Use the `EmitEvent` function to emit a "new_player" event with the new player's ID
@@ -0,0 +1,66 @@
+// Package system contains the logic for handling ability training actions.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+/**
+ * PetPracticeMagicAction practices the pet's magic.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Start a "Practicing" activity of `game.AbilityTrainingTicks`, spending `game.MagicPracticeEnergy` energy
+ *    (see `startAbilityActivity`).
+ * 3. When the practice ends, the pet's Magic earns `game.MagicPracticeXP` experience, levelling it up on the
+ *    `component.CalculateNextLevelXP` curve. Practice stopped early earns its share (see `component.ActivityRewards`).
+ * 4. Return a reply with the magic's progress and the activity started.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func PetPracticeMagicAction(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(
+		world,
+		func(practice cardinal.TxData[msg.PracticeMagicMsg]) (msg.PracticeMagicMsgReply, error) {
+			petId, err := findOwnedPet(world, practice.Tx.PersonaTag, practice.Msg.TargetNickname)
+			if err != nil {
+				return msg.PracticeMagicMsgReply{}, err
+			}
+
+			// Check the magic can still grow
+			magic, err := component.GetPetMagic(world, petId)
+			if err != nil {
+				return msg.PracticeMagicMsgReply{}, err
+			}
+			if magic.Level >= game.MaxAbilityLevel {
+				return msg.PracticeMagicMsgReply{}, fmt.Errorf("magic Max lvl, cant grow more")
+			}
+
+			// Step 2: Start the activity, earning the experience when it ends
+			activity, err := startAbilityActivity(world, petId, game.ActivityPracticing, game.MagicPracticeEnergy, game.MagicPracticeXP)
+			if err != nil {
+				return msg.PracticeMagicMsgReply{}, err
+			}
+
+			// Step 4: Reply with the progress
+			return msg.PracticeMagicMsgReply{
+				Kind:        magic.Kind,
+				Level:       magic.Level,
+				XP:          magic.XP,
+				NextLevelXP: magic.NextLevelXP,
+				Energy:      game.MagicPracticeEnergy,
+				Activity:    activity.Activity,
+				Duration:    activity.CountDown,
+			}, nil
+		})
+}
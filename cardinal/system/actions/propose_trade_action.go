@@ -0,0 +1,139 @@
+// Package system contains the logic for handling trade actions.
+package system
+
+import (
+	"errors"
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+/**
+ * ProposeTradeAction offers items, money and pets to another persona in exchange for theirs.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Check the proposer and the recipient are different, existing players.
+ * 3. Resolve the item names and pet nicknames of the offer and the request.
+ * 4. Create the trade entity, expiring after `game.TradeExpiryTicks`.
+ * 5. Take the offer from the proposer into escrow, removing the trade again if that fails.
+ * 6. Emit a `trade_proposed` event and reply with the trade ID.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func ProposeTradeAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	return cardinal.EachMessage(
+		world,
+		func(propose cardinal.TxData[msg.ProposeTradeMsg]) (msg.ProposeTradeMsgReply, error) {
+			// Step 2: Players sanity check
+			if propose.Msg.Recipient == propose.Tx.PersonaTag {
+				return msg.ProposeTradeMsgReply{}, fmt.Errorf("failed to propose trade [cannot trade with yourself]")
+			}
+			proposerID, err := component.FindPlayerByPersonaTag(world, propose.Tx.PersonaTag)
+			if err != nil {
+				return msg.ProposeTradeMsgReply{}, err
+			}
+			if _, err := component.FindPlayerByPersonaTag(world, propose.Msg.Recipient); err != nil {
+				return msg.ProposeTradeMsgReply{}, fmt.Errorf("failed to propose trade [recipient %s]: %w", propose.Msg.Recipient, err)
+			}
+
+			// Step 3: Resolve both sides of the trade
+			offer, err := resolveTradeAssets(world, propose.Tx.PersonaTag, propose.Msg.Offer)
+			if err != nil {
+				return msg.ProposeTradeMsgReply{}, err
+			}
+			request, err := resolveTradeAssets(world, propose.Msg.Recipient, propose.Msg.Request)
+			if err != nil {
+				return msg.ProposeTradeMsgReply{}, err
+			}
+			if offer.IsEmpty() && request.IsEmpty() {
+				return msg.ProposeTradeMsgReply{}, fmt.Errorf("failed to propose trade [nothing to trade]")
+			}
+
+			// Step 4: Create the trade
+			trade := component.Trade{
+				Proposer:    propose.Tx.PersonaTag,
+				Recipient:   propose.Msg.Recipient,
+				Offer:       offer,
+				Request:     request,
+				CreatedTick: world.CurrentTick(),
+				ExpiresTick: world.CurrentTick() + game.TradeExpiryTicks,
+			}
+			tradeID, err := cardinal.Create(world, trade)
+			if err != nil {
+				return msg.ProposeTradeMsgReply{}, fmt.Errorf("failed to propose trade [create Trade]: %w", err)
+			}
+
+			// Step 5: Hold the offer in escrow
+			if err := component.TakeEscrow(world, proposerID, offer, game.LedgerTrade, fmt.Sprintf("trade %d", tradeID)); err != nil {
+				if removeErr := cardinal.Remove(world, tradeID); removeErr != nil {
+					return msg.ProposeTradeMsgReply{}, errors.Join(err, removeErr)
+				}
+				return msg.ProposeTradeMsgReply{}, err
+			}
+			log.Info().Msgf("Trade: %s proposed trade %d to %s", trade.Proposer, tradeID, trade.Recipient)
+
+			// Step 6: Emit the `trade_proposed` event
+			if err := world.EmitEvent(map[string]any{
+				"event": "trade_proposed",
+				"id":    tradeID,
+			}); err != nil {
+				return msg.ProposeTradeMsgReply{}, err
+			}
+			return msg.ProposeTradeMsgReply{TradeID: tradeID, ExpiresTick: trade.ExpiresTick}, nil
+		})
+}
+
+/**
+ * resolveTradeAssets turns the item names and pet nicknames of one side of a trade into entity IDs.
+ *
+ * Code Flow:
+ * 1. Reject negative money.
+ * 2. Find every item by name, rejecting non positive quantities.
+ * 3. Find every pet by nickname and check it belongs to the given persona.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   personaTag (string): The persona giving the assets.
+ *   assets (msg.TradeAssets): The assets as sent by the client.
+ *
+ * Returns:
+ *   (component.Escrow, error): The assets, and any error that occurs during the process.
+ */
+func resolveTradeAssets(world cardinal.WorldContext, personaTag string, assets msg.TradeAssets) (component.Escrow, error) {
+	escrow := component.Escrow{Money: assets.Money}
+	if assets.Money < 0 {
+		return component.Escrow{}, fmt.Errorf("failed to propose trade [invalid amount %s]", assets.Money)
+	}
+	for _, item := range assets.Items {
+		if item.Quantity <= 0 {
+			return component.Escrow{}, fmt.Errorf("failed to propose trade [invalid quantity %d of %s]", item.Quantity, item.Name)
+		}
+		itemID, err := component.FindItemByName(world, item.Name)
+		if err != nil {
+			return component.Escrow{}, err
+		}
+		escrow.Items = append(escrow.Items, component.ItemStack{ItemID: itemID, Quantity: item.Quantity})
+	}
+	for _, nickname := range assets.Pets {
+		petID, pet, err := component.GetPetByNickname(world, nickname)
+		if err != nil {
+			return component.Escrow{}, err
+		}
+		if pet.PersonaTag != personaTag {
+			return component.Escrow{}, fmt.Errorf("failed to propose trade [%s does not belong to %s]", nickname, personaTag)
+		}
+		escrow.Pets = append(escrow.Pets, petID)
+	}
+	return escrow, nil
+}
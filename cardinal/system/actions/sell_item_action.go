@@ -0,0 +1,109 @@
+// Package system contains the logic for handling item selling actions.
+package system
+
+import (
+	"errors"
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+/**
+ * Function Flow:
+ * 1. Check if the player exists and is valid.
+ * 2. Check if the item exists, is traded by a store and the quantity is valid (1 when omitted, at most `game.MaxStackQuantity`).
+ * 3. Check the player owns enough units of the item.
+ * 4. Remove the items from the player's inventory.
+ * 5. Credit the player with `game.SellBackPercent` of the item's price, failing if the total overflows,
+ *    recorded as a sale in the ledger.
+ *    If the credit fails, the items are given back so the sale is all or nothing.
+ * 6. Return a reply with the money earned and the quantity left.
+ *
+ * SellItemAction handles selling owned items back to the stores.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the sell action.
+ */
+func SellItemAction(world cardinal.WorldContext) error {
+	log := world.Logger()
+	return cardinal.EachMessage(
+		world,
+		func(sellItem cardinal.TxData[msg.SellItemMsg]) (msg.SellItemMsgReply, error) {
+			log.Info().Msgf("sellItem: n[%s] q[%d]", sellItem.Msg.Name, sellItem.Msg.Quantity)
+
+			quantity := sellItem.Msg.Quantity
+			if quantity == 0 {
+				quantity = 1
+			}
+			if quantity < 0 || quantity > game.MaxStackQuantity {
+				return msg.SellItemMsgReply{}, fmt.Errorf("error Selling, invalid quantity [%d, at most %d]", quantity, game.MaxStackQuantity)
+			}
+
+			// Step 1: Player sanity check
+			playerID, err := component.FindPlayerByPersonaTag(world, sellItem.Tx.PersonaTag)
+			if err != nil {
+				return msg.SellItemMsgReply{}, err
+			}
+			player, err := cardinal.GetComponent[component.Player](world, playerID)
+			if err != nil {
+				return msg.SellItemMsgReply{}, fmt.Errorf("failed to sell [get Player]: %w", err)
+			}
+
+			// Step 2: Item sanity check
+			itemId, err := component.FindItemByName(world, sellItem.Msg.Name)
+			if err != nil {
+				return msg.SellItemMsgReply{}, err
+			}
+			item, err := cardinal.GetComponent[component.Item](world, itemId)
+			if err != nil {
+				return msg.SellItemMsgReply{}, err
+			}
+			store, err := component.FindStoreForItem(world, itemId)
+			if err != nil {
+				return msg.SellItemMsgReply{}, err
+			}
+
+			// Step 3: Ownership check
+			if !player.HasItem(itemId) {
+				return msg.SellItemMsgReply{}, fmt.Errorf("error Selling, item %s does not belong to the player", item.ItemName)
+			}
+			if owned := player.ItemQuantity(itemId); owned < quantity {
+				return msg.SellItemMsgReply{}, fmt.Errorf("error Selling, not enough %s [have %d, selling %d]", item.ItemName, owned, quantity)
+			}
+
+			// Step 4: Price the sale, then remove the items
+			total, err := item.Price.Times(quantity)
+			if err != nil {
+				return msg.SellItemMsgReply{}, fmt.Errorf("error Selling %s: %w", item.ItemName, err)
+			}
+			earned := total.Percent(game.SellBackPercent)
+			if err := component.RemovePlayerItems(world, playerID, itemId, quantity); err != nil {
+				return msg.SellItemMsgReply{}, err
+			}
+
+			// Step 5: Credit the player, giving the items back if that fails
+			if err := component.IncreasePlayerMoney(world, playerID, earned, game.LedgerSale, item.ItemName); err != nil {
+				if restoreErr := component.AddPlayerItems(world, playerID, itemId, quantity); restoreErr != nil {
+					return msg.SellItemMsgReply{}, errors.Join(err, restoreErr)
+				}
+				return msg.SellItemMsgReply{}, err
+			}
+			log.Info().Msgf("sellItem: sold %d %s to %s for %s", quantity, item.ItemName, store, earned)
+
+			// Step 6: Reply with the money earned and the quantity left
+			player, err = cardinal.GetComponent[component.Player](world, playerID)
+			if err != nil {
+				return msg.SellItemMsgReply{}, err
+			}
+			return msg.SellItemMsgReply{
+				Success:  true,
+				Earned:   earned,
+				Quantity: player.ItemQuantity(itemId),
+			}, nil
+		},
+	)
+}
@@ -0,0 +1,143 @@
+// Package system contains the logic for handling ability training actions.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * PetTrainSkillAction trains the pet's skill.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Start a "Training" activity of `game.AbilityTrainingTicks`, spending `game.SkillTrainEnergy` energy
+ *    (see `startAbilityActivity`).
+ * 3. When the training ends, the pet's Skill earns `game.SkillTrainXP` experience, levelling it up on the
+ *    `component.CalculateNextLevelXP` curve. Training stopped early earns its share (see `component.ActivityRewards`).
+ * 4. Return a reply with the skill's progress and the activity started.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func PetTrainSkillAction(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(
+		world,
+		func(train cardinal.TxData[msg.TrainSkillMsg]) (msg.TrainSkillMsgReply, error) {
+			petId, err := findOwnedPet(world, train.Tx.PersonaTag, train.Msg.TargetNickname)
+			if err != nil {
+				return msg.TrainSkillMsgReply{}, err
+			}
+
+			// Check the skill can still grow
+			skill, err := component.GetPetSkill(world, petId)
+			if err != nil {
+				return msg.TrainSkillMsgReply{}, err
+			}
+			if skill.Level >= game.MaxAbilityLevel {
+				return msg.TrainSkillMsgReply{}, fmt.Errorf("skill Max lvl, cant grow more")
+			}
+
+			// Step 2: Start the activity, earning the experience when it ends
+			activity, err := startAbilityActivity(world, petId, game.ActivityTraining, game.SkillTrainEnergy, game.SkillTrainXP)
+			if err != nil {
+				return msg.TrainSkillMsgReply{}, err
+			}
+
+			// Step 4: Reply with the progress
+			return msg.TrainSkillMsgReply{
+				Kind:        skill.Kind,
+				Level:       skill.Level,
+				XP:          skill.XP,
+				NextLevelXP: skill.NextLevelXP,
+				Energy:      game.SkillTrainEnergy,
+				Activity:    activity.Activity,
+				Duration:    activity.CountDown,
+			}, nil
+		})
+}
+
+/**
+ * findOwnedPet returns the ID of a living pet of the persona.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   personaTag (string): The persona tag of the owner.
+ *   nickname (string): The nickname of the pet.
+ *
+ * Returns:
+ *   (types.EntityID, error): The ID of the pet, and an error if the persona has no such living pet.
+ */
+func findOwnedPet(world cardinal.WorldContext, personaTag string, nickname string) (types.EntityID, error) {
+	playerID, err := component.FindPlayerByPersonaTag(world, personaTag)
+	if err != nil {
+		return 0, err
+	}
+	player, err := cardinal.GetComponent[component.Player](world, playerID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find pet [get Player]: %w", err)
+	}
+	petId, err := player.GetPetNickname(world, nickname)
+	if err != nil {
+		return 0, err
+	}
+	if err := system.CheckPetAlive(world, petId); err != nil {
+		return 0, err
+	}
+	return petId, nil
+}
+
+/**
+ * startAbilityActivity starts a timed training activity for the pet.
+ *
+ * Code Flow:
+ * 1. Check the pet is not currently engaged in an activity using `CheckPetActivity`, nor too sick for this one.
+ * 2. Check the pet has more energy than the activity costs, and spend it.
+ * 3. Start the activity, which lasts `game.AbilityTrainingTicks` and earns its experience when it ends
+ *    (see `component.StartPetActivityWith`).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   name (string): The name of the activity, e.g. `game.ActivityTraining`.
+ *   energyCost (int): The energy spent by the activity.
+ *   xp (int64): The experience earned when the activity ends.
+ *
+ * Returns:
+ *   (*component.Activity, error): The activity started, and any error that occurs during the process.
+ */
+func startAbilityActivity(world cardinal.WorldContext, petId types.EntityID, name string, energyCost int, xp int64) (*component.Activity, error) {
+	// Step 1: Check the pet is idle
+	if err := system.CheckPetActivity(world, petId); err != nil {
+		return nil, err
+	}
+	if err := system.CheckPetFitFor(world, petId, name); err != nil {
+		return nil, err
+	}
+
+	// Step 2: Spend the energy
+	petEnergy, err := component.GetPetEnergy(world, petId)
+	if err != nil {
+		return nil, err
+	}
+	if petEnergy.E-energyCost <= 0 {
+		return nil, fmt.Errorf("pet energy is insufficient")
+	}
+	petEnergy.E -= energyCost
+	if err := cardinal.SetComponent(world, petId, petEnergy); err != nil {
+		return nil, fmt.Errorf("failed to %s [set Energy]: %w", name, err)
+	}
+
+	// Step 3: Start the activity
+	return component.StartPetActivityWith(world, petId, name, nil, xp)
+}
@@ -0,0 +1,77 @@
+// Package system contains the logic for using items on pets.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * PetUseItemAction uses any owned item on a pet.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Find the item among the player's items and pick how it is used from its kind and effects:
+ *    food is eaten, toys are played with, care items affecting hygiene give a bath and other care items cure.
+ * 3. Use the quantity of the item, 1 when omitted (see `system.UseItems`).
+ * 4. Return a reply with the actual change of every stat and the activity started, whose end applies the rest of the item's effects.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func PetUseItemAction(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(
+		world,
+		func(use cardinal.TxData[msg.UseItemMsg]) (msg.UseItemMsgReply, error) {
+			// Step 2: Pick how the item is used
+			playerID, err := component.FindPlayerByPersonaTag(world, use.Tx.PersonaTag)
+			if err != nil {
+				return msg.UseItemMsgReply{}, err
+			}
+			player, err := cardinal.GetComponent[component.Player](world, playerID)
+			if err != nil {
+				return msg.UseItemMsgReply{}, fmt.Errorf("failed to use item [get Player]: %w", err)
+			}
+			item, err := player.GetItemByName(world, use.Msg.ItemName)
+			if err != nil {
+				return msg.UseItemMsgReply{}, err
+			}
+			itemId, err := player.GetItemIdByName(world, use.Msg.ItemName)
+			if err != nil {
+				return msg.UseItemMsgReply{}, err
+			}
+			how, err := system.ItemUseFor(item, component.GetItemEffects(world, itemId))
+			if err != nil {
+				return msg.UseItemMsgReply{}, err
+			}
+
+			// Step 3: Use the item
+			quantity := use.Msg.Quantity
+			if quantity == 0 {
+				quantity = 1
+			}
+			result, err := system.UseItems(world, use.Tx.PersonaTag, use.Msg.TargetNickname, use.Msg.ItemName, how, quantity)
+			if err != nil {
+				return msg.UseItemMsgReply{}, err
+			}
+
+			// Step 4: Reply with the actual changes
+			return msg.UseItemMsgReply{
+				Health:   result.Deltas.Health,
+				Energy:   result.Deltas.Energy,
+				Hygiene:  result.Deltas.Hygiene,
+				Wellness: result.Deltas.Wellness,
+				Satiety:  result.Deltas.Satiety,
+				Activity: result.Activity.Activity,
+				Duration: result.Activity.CountDown,
+			}, nil
+		})
+}
@@ -0,0 +1,89 @@
+// Package system contains the logic for parking pets while their owner is away.
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/system"
+)
+
+/**
+ * VacationModeAction parks a pet while its owner is away. The pet returns on its own after the chosen number
+ * of hours, or earlier with the cancel-activity message.
+ *
+ * Code Flow:
+ * 1. Process each incoming message using `cardinal.EachMessage`.
+ * 2. Find the player's living pet (see `findOwnedPet`).
+ * 3. Look up the activity of the mode (`game.VacationActivities`) and check the number of hours.
+ * 4. Check the pet is not currently engaged in an activity using `CheckPetActivity`.
+ * 5. Charge the first hour to the player, recorded as vacation in the ledger. The next hours are charged
+ *    by `ActivityDeclineSystem`, which brings the pet home when the player cannot pay.
+ * 6. Start the activity for the chosen duration. While it runs the pet's stats decline at the reduced rate
+ *    of the activity (see `game.ActivityKind`).
+ * 7. Return a reply with the activity, when the pet returns and the hourly cost.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: Any error that occurs during the process.
+ */
+func VacationModeAction(world cardinal.WorldContext) error {
+	return cardinal.EachMessage(
+		world,
+		func(vacation cardinal.TxData[msg.VacationModeMsg]) (msg.VacationModeMsgReply, error) {
+			// Step 2: Find the pet
+			petId, err := findOwnedPet(world, vacation.Tx.PersonaTag, vacation.Msg.TargetNickname)
+			if err != nil {
+				return msg.VacationModeMsgReply{}, err
+			}
+
+			// Step 3: Look up the activity and check the duration
+			name, ok := game.VacationActivities[vacation.Msg.Mode]
+			if !ok {
+				return msg.VacationModeMsgReply{}, fmt.Errorf("failed to start vacation [unknown mode %q]", vacation.Msg.Mode)
+			}
+			kind, _ := game.ActivityByName(name)
+			if vacation.Msg.Hours <= 0 || vacation.Msg.Hours > game.MaxVacationHours {
+				return msg.VacationModeMsgReply{}, fmt.Errorf("failed to start vacation [hours must be between 1 and %d]", game.MaxVacationHours)
+			}
+
+			// Step 4: Check the pet is free
+			if err := system.CheckPetActivity(world, petId); err != nil {
+				return msg.VacationModeMsgReply{}, err
+			}
+
+			// Step 5: Charge the first hour
+			playerId, err := component.FindPlayerByPersonaTag(world, vacation.Tx.PersonaTag)
+			if err != nil {
+				return msg.VacationModeMsgReply{}, err
+			}
+			if err := component.ReducePlayerMoney(world, playerId, kind.HourlyCost, game.LedgerVacation, vacation.Msg.TargetNickname); err != nil {
+				return msg.VacationModeMsgReply{}, err
+			}
+
+			// Step 6: Start the activity for the chosen duration
+			activity, err := component.StartPetActivity(world, petId, name)
+			if err != nil {
+				return msg.VacationModeMsgReply{}, err
+			}
+			activity.CountDown = vacation.Msg.Hours * game.TickHour
+			activity.TotalTicks = activity.CountDown
+			if err := cardinal.SetComponent(world, petId, activity); err != nil {
+				return msg.VacationModeMsgReply{}, fmt.Errorf("failed to start vacation [set Activity]: %w", err)
+			}
+
+			// Step 7: Reply with the vacation
+			return msg.VacationModeMsgReply{
+				Activity:   activity.Activity,
+				Duration:   activity.TotalTicks,
+				ReturnTick: world.CurrentTick() + uint64(activity.TotalTicks),
+				HourlyCost: kind.HourlyCost,
+			}, nil
+		})
+}
@@ -0,0 +1,106 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+// queuedItemUses maps the queued actions using an item to how the item is used.
+var queuedItemUses = map[string]ItemUse{
+	game.QueueFeed: FeedUse,
+	game.QueueBath: BathUse,
+	game.QueuePlay: PlayUse,
+}
+
+/**
+ * CheckQueuedActivity checks an action can be queued by a player.
+ *
+ * Code Flow:
+ * 1. Check the action is one of the `game.Queue*` actions.
+ * 2. For actions using an item, check the player owns the item. It is only consumed when the action starts.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   player (*component.Player): The player queuing the action.
+ *   action (string): The action to queue.
+ *   itemName (string): The item used by the action, empty for sleep.
+ *
+ * Returns:
+ *   error: An error if the action cannot be queued.
+ */
+func CheckQueuedActivity(world cardinal.WorldContext, player *component.Player, action string, itemName string) error {
+	// Step 1: Check the action
+	if action == game.QueueSleep {
+		return nil
+	}
+	if _, ok := queuedItemUses[action]; !ok {
+		return fmt.Errorf("failed to queue activity [unknown action %q]", action)
+	}
+
+	// Step 2: Check the item
+	if itemName == "" {
+		return fmt.Errorf("failed to queue activity [%s needs an item]", action)
+	}
+	if _, err := player.GetItemIdByName(world, itemName); err != nil {
+		return err
+	}
+	return nil
+}
+
+/**
+ * StartQueuedActivity starts a queued action on a pet, as if its owner had just sent it.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   personaTag (string): The persona tag of the owner of the pet.
+ *   nickname (string): The nickname of the pet.
+ *   queued (component.QueuedActivity): The action to start.
+ *
+ * Returns:
+ *   (*component.Activity, error): The activity started, and an error if the action cannot start.
+ */
+func StartQueuedActivity(world cardinal.WorldContext, personaTag string, nickname string, queued component.QueuedActivity) (*component.Activity, error) {
+	if queued.Action == game.QueueSleep {
+		playerID, err := component.FindPlayerByPersonaTag(world, personaTag)
+		if err != nil {
+			return nil, err
+		}
+		player, err := cardinal.GetComponent[component.Player](world, playerID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to sleep [get Player]: %w", err)
+		}
+		petId, err := player.GetPetNickname(world, nickname)
+		if err != nil {
+			return nil, err
+		}
+		return StartSleep(world, petId)
+	}
+
+	use, ok := queuedItemUses[queued.Action]
+	if !ok {
+		return nil, fmt.Errorf("failed to start queued activity [unknown action %q]", queued.Action)
+	}
+	result, err := UseItem(world, personaTag, nickname, queued.ItemName, use)
+	if err != nil {
+		return nil, err
+	}
+	return result.Activity, nil
+}
+
+/**
+ * StartSleep puts a living, idle pet to sleep (see `game.ActivityKinds`).
+ */
+func StartSleep(world cardinal.WorldContext, petId types.EntityID) (*component.Activity, error) {
+	if err := CheckPetAlive(world, petId); err != nil {
+		return nil, err
+	}
+	if err := CheckPetActivity(world, petId); err != nil {
+		return nil, err
+	}
+	return component.StartPetActivity(world, petId, game.ActivitySleeping)
+}
@@ -0,0 +1,34 @@
+// Package system contains the logic for keeping the store items in line with the item catalog.
+package system
+
+import (
+	"fmt"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+
+	"pkg.world.dev/world-engine/cardinal"
+)
+
+/**
+ * Function Flow:
+ * 1. The `CatalogSystem` function is called on every tick, after the stores were spawned.
+ * 2. It loads the item catalog (see `game.Catalog`).
+ * 3. It syncs the store items with the catalog (see `component.SyncCatalogItems`), which only does work
+ *    the first time the world runs with a new version of the catalog, e.g. after a restart with an edited catalog.
+ *
+ * CatalogSystem makes price and effect changes to the item catalog reach worlds that already have their store items.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if the catalog is invalid or the store items could not be updated.
+ */
+func CatalogSystem(world cardinal.WorldContext) error {
+	// Step 2: Load the item catalog
+	catalog, err := game.Catalog()
+	if err != nil {
+		return fmt.Errorf("failed to sync store items: %w", err)
+	}
+
+	// Step 3: Sync the store items
+	return component.SyncCatalogItems(world, catalog)
+}
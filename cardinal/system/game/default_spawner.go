@@ -2,27 +2,38 @@
 package system
 
 import (
+	"fmt"
+
 	"tamagotchi/component"
+	"tamagotchi/game"
 
 	"pkg.world.dev/world-engine/cardinal"
 )
 
 /**
  * Function Flow:
- * 1. The `SpawnDefaultSystem` function is called, which creates a Leaderboard entity.
- * 2. The function then attempts to create a Drug Store, Food Store, and Toy Store.
- * 3. For each store, the function calls a corresponding creation function (`createDrugStore`, `createFoodStore`, `createToyStore`).
- * 4. If any of the store creation functions return an error, the `SpawnDefaultSystem` function will return that error.
+ * 1. The `SpawnDefaultSystem` function is called, which loads the item catalog, failing the world start if it is invalid.
+ * 2. The function creates a Leaderboard entity.
+ * 3. The function then attempts to create a Drug Store, Food Store, and Toy Store.
+ * 4. For each store, the function calls a corresponding creation function (`createDrugStore`, `createFoodStore`, `createToyStore`).
+ * 5. If any of the store creation functions return an error, the `SpawnDefaultSystem` function will return that error.
  *
  * SpawnDefaultSystem creates a Leaderboard and default stores. This System is registered as an
- * Init system, meaning it will be executed exactly one time on tick 0.
+ * Init system, meaning it will be executed exactly one time on tick 0. Later changes to the item catalog
+ * reach the store items through `CatalogSystem`.
  *
  * @param world The WorldContext for the game.
  * @return error if any error occurs during the spawning of default systems.
  */
 func SpawnDefaultSystem(world cardinal.WorldContext) error {
 
-	// Step 1: Create LeaderBoard
+	// Step 1: Load the item catalog
+	//   - The stores are stocked from the catalog, so an invalid catalog stops the world from starting
+	if _, err := game.Catalog(); err != nil {
+		return fmt.Errorf("failed to spawn default stores: %w", err)
+	}
+
+	// Step 2: Create LeaderBoard
 	//   - Create a new Leaderboard entity using the `Create` function
 	//   - If the creation fails, return the error
 	_, err := cardinal.Create(world,
@@ -32,7 +43,7 @@ func SpawnDefaultSystem(world cardinal.WorldContext) error {
 		return err
 	}
 
-	// Step 2: Create Drug Store
+	// Step 3: Create Drug Store
 	//   - Call the `createDrugStore` function to create a new Drug Store entity
 	//   - If the function returns an error, return that error
 	shouldReturn, err := createDrugStore(world)
@@ -40,7 +51,7 @@ func SpawnDefaultSystem(world cardinal.WorldContext) error {
 		return err
 	}
 
-	// Step 3: Create Food Store
+	// Step 4: Create Food Store
 	//   - Call the `createFoodStore` function to create a new Food Store entity
 	//   - If the function returns an error, return that error
 	shouldReturn, err = createFoodStore(world)
@@ -48,7 +59,7 @@ func SpawnDefaultSystem(world cardinal.WorldContext) error {
 		return err
 	}
 
-	// Step 4: Create Toy Store
+	// Step 5: Create Toy Store
 	//   - Call the `createToyStore` function to create a new Toy Store entity
 	//   - If the function returns an error, return that error
 	shouldReturn, err = createToyStore(world)
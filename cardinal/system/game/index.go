@@ -0,0 +1,23 @@
+// Package system contains the logic for maintaining the index of the world.
+package system
+
+import (
+	"tamagotchi/component"
+
+	"pkg.world.dev/world-engine/cardinal"
+)
+
+/**
+ * Function Flow:
+ * 1. The `IndexSystem` function is called on every tick, before any system looks an entity up by name.
+ * 2. It creates the `Index` of the world when the world does not have one yet (see `component.EnsureIndex`),
+ *    which also indexes the entities of worlds created before the index existed.
+ *
+ * IndexSystem makes sure the world has an Index, which is then kept up to date as pets, players and items are created.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if the Index could not be created.
+ */
+func IndexSystem(world cardinal.WorldContext) error {
+	return component.EnsureIndex(world)
+}
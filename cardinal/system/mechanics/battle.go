@@ -0,0 +1,266 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The `BattleSystem` function is called, which checks if the current tick is a multiple of `game.BattleTickRate`.
+ * 2. If it is, the function collects all battles that are pending or fighting, and the battles that finished
+ *    more than `game.BattleRetentionTicks` ago.
+ * 3. Pending battles past their `ExpiresTick` are removed.
+ * 4. Fighting battles resolve one round (see `fightRound`), and finish once a pet is out of battle health,
+ *    dies, or `game.BattleMaxRounds` rounds were fought.
+ * 5. Finished battles past their retention are removed with their log.
+ *
+ * BattleSystem resolves pet battles over ticks.
+ *
+ * Battles are deterministic: the faster pet (skill speed plus level, the challenger on a tie) attacks first,
+ * and damage only depends on the pets' element, skill, level and energy (see `game.BattleDamage`).
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the execution of the battle system.
+ */
+func BattleSystem(world cardinal.WorldContext) error {
+	// Step 1: Check if the current tick is a multiple of `game.BattleTickRate`
+	if world.CurrentTick()%game.BattleTickRate != 0 {
+		return nil
+	}
+	log := world.Logger()
+
+	// Step 2: Collect the battles that are not finished, and the finished battles past their retention
+	var battleIds, expiredIds []types.EntityID
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Battle]())).
+		Each(world, func(battleId types.EntityID) bool {
+			battle, err := cardinal.GetComponent[component.Battle](world, battleId)
+			if err != nil {
+				return true
+			}
+			if battle.Status != component.BattleFinished {
+				battleIds = append(battleIds, battleId)
+			} else if world.CurrentTick() >= battle.ExpiresTick {
+				expiredIds = append(expiredIds, battleId)
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+
+	for _, battleId := range battleIds {
+		battle, err := component.GetBattle(world, battleId)
+		if err != nil {
+			return err
+		}
+
+		// Step 3: Drop expired challenges
+		if battle.Status == component.BattlePending {
+			if world.CurrentTick() >= battle.ExpiresTick {
+				if err := cardinal.Remove(world, battleId); err != nil {
+					return fmt.Errorf("failed to expire battle %d: %w", battleId, err)
+				}
+				log.Info().Msgf("Battle: challenge %d expired", battleId)
+			}
+			continue
+		}
+
+		// Step 4: Fight a round
+		if err := fightRound(world, battleId, battle); err != nil {
+			return err
+		}
+	}
+
+	// Step 5: Remove the finished battles past their retention
+	for _, battleId := range expiredIds {
+		if err := cardinal.Remove(world, battleId); err != nil {
+			return fmt.Errorf("failed to remove battle %d: %w", battleId, err)
+		}
+	}
+	return nil
+}
+
+/**
+ * fightRound resolves one round of a battle.
+ *
+ * Code Flow:
+ * 1. Finish the battle if one of the pets died since the last round.
+ * 2. Order the pets by speed (skill speed plus level), the challenger first on a tie.
+ * 3. Each pet attacks in turn, spending `game.BattleEnergyCost` energy, and the attack is logged (see `logAttack`).
+ *    The battle finishes as soon as the defender is out of battle health.
+ * 4. After `game.BattleMaxRounds` rounds, the pet with the most battle health left wins, or it is a draw.
+ */
+func fightRound(world cardinal.WorldContext, battleId types.EntityID, battle *component.Battle) error {
+	// Step 1: A pet that died cannot fight on
+	challengerDead := component.IsPetDeceased(world, battle.ChallengerPet)
+	opponentDead := component.IsPetDeceased(world, battle.OpponentPet)
+	if challengerDead || opponentDead {
+		winner := types.EntityID(0)
+		if !challengerDead {
+			winner = battle.ChallengerPet
+		} else if !opponentDead {
+			winner = battle.OpponentPet
+		}
+		return finishBattle(world, battleId, battle, winner)
+	}
+
+	// Step 2: Order the pets by speed
+	challenger, err := component.GetPetCombatant(world, battle.ChallengerPet)
+	if err != nil {
+		return err
+	}
+	opponent, err := component.GetPetCombatant(world, battle.OpponentPet)
+	if err != nil {
+		return err
+	}
+	attackers := []types.EntityID{battle.ChallengerPet, battle.OpponentPet}
+	if opponent.Stats.Speed+int(opponent.Level) > challenger.Stats.Speed+int(challenger.Level) {
+		attackers[0], attackers[1] = attackers[1], attackers[0]
+	}
+
+	// Step 3: Each pet attacks in turn
+	battle.Round++
+	for _, attackerId := range attackers {
+		defenderId, defenderHP := battle.OpponentPet, &battle.OpponentHP
+		if attackerId == battle.OpponentPet {
+			defenderId, defenderHP = battle.ChallengerPet, &battle.ChallengerHP
+		}
+
+		attacker, err := component.GetPetCombatant(world, attackerId)
+		if err != nil {
+			return err
+		}
+		defender, err := component.GetPetCombatant(world, defenderId)
+		if err != nil {
+			return err
+		}
+		damage := game.BattleDamage(attacker, defender)
+		*defenderHP = max(*defenderHP-damage, 0)
+		if err := spendBattleEnergy(world, attackerId); err != nil {
+			return err
+		}
+		if err := logAttack(world, battleId, component.BattleRound{
+			Round:      battle.Round,
+			Tick:       world.CurrentTick(),
+			Attacker:   attackerId,
+			Defender:   defenderId,
+			Damage:     damage,
+			DefenderHP: *defenderHP,
+		}); err != nil {
+			return err
+		}
+		if *defenderHP == 0 {
+			return finishBattle(world, battleId, battle, attackerId)
+		}
+	}
+
+	// Step 4: Decide the battle once the rounds run out
+	if battle.Round >= game.BattleMaxRounds {
+		winner := types.EntityID(0)
+		if battle.ChallengerHP > battle.OpponentHP {
+			winner = battle.ChallengerPet
+		} else if battle.OpponentHP > battle.ChallengerHP {
+			winner = battle.OpponentPet
+		}
+		return finishBattle(world, battleId, battle, winner)
+	}
+	if err := cardinal.SetComponent(world, battleId, battle); err != nil {
+		return fmt.Errorf("failed to fight [set Battle]: %w", err)
+	}
+	return nil
+}
+
+// spendBattleEnergy takes the energy cost of an attack from a pet.
+func spendBattleEnergy(world cardinal.WorldContext, petId types.EntityID) error {
+	energy, err := component.GetPetEnergy(world, petId)
+	if err != nil {
+		return err
+	}
+	energy.E = max(energy.E-game.BattleEnergyCost, 0)
+	if err := cardinal.SetComponent(world, petId, energy); err != nil {
+		return fmt.Errorf("failed to fight [set Energy]: %w", err)
+	}
+	return nil
+}
+
+// logAttack appends an attack to the log of a battle, adding the BattleLog component to battles created without one.
+func logAttack(world cardinal.WorldContext, battleId types.EntityID, round component.BattleRound) error {
+	log, err := cardinal.GetComponent[component.BattleLog](world, battleId)
+	if err != nil {
+		if err := cardinal.AddComponentTo[component.BattleLog](world, battleId); err != nil {
+			return fmt.Errorf("failed to fight [add BattleLog]: %w", err)
+		}
+		log = &component.BattleLog{}
+	}
+	log.Rounds = append(log.Rounds, round)
+	if err := cardinal.SetComponent(world, battleId, log); err != nil {
+		return fmt.Errorf("failed to fight [set BattleLog]: %w", err)
+	}
+	return nil
+}
+
+/**
+ * finishBattle records the outcome of a battle.
+ *
+ * Code Flow:
+ * 1. Mark the battle finished with its winner (0 for a draw), expiring after `game.BattleRetentionTicks`, and save it.
+ * 2. Award `game.BattleWinXP` to the winner and `game.BattleLoseXP` to the other living pets through `Pet.AddXP`.
+ * 3. Free both pets from the "Battling" activity.
+ * 4. Emit a `battle_finished` event.
+ */
+func finishBattle(world cardinal.WorldContext, battleId types.EntityID, battle *component.Battle, winner types.EntityID) error {
+	log := world.Logger()
+
+	// Step 1: Mark the battle finished
+	battle.Status = component.BattleFinished
+	battle.Winner = winner
+	battle.FinishedTick = world.CurrentTick()
+	battle.ExpiresTick = world.CurrentTick() + game.BattleRetentionTicks
+	if err := cardinal.SetComponent(world, battleId, battle); err != nil {
+		return fmt.Errorf("failed to finish battle [set Battle]: %w", err)
+	}
+
+	for _, petId := range []types.EntityID{battle.ChallengerPet, battle.OpponentPet} {
+		// Step 2: Award experience
+		pet, err := cardinal.GetComponent[component.Pet](world, petId)
+		if err != nil {
+			return fmt.Errorf("failed to finish battle [get Pet]: %w", err)
+		}
+		if !component.IsPetDeceased(world, petId) && pet.Level < game.MaxLevel {
+			xp := int64(game.BattleLoseXP)
+			if petId == winner {
+				xp = game.BattleWinXP
+			}
+			pet.AddXP(xp)
+			if err := cardinal.SetComponent(world, petId, pet); err != nil {
+				return fmt.Errorf("failed to finish battle [set Pet]: %w", err)
+			}
+		}
+
+		// Step 3: Free the pet
+		activity, err := component.GetPetActivity(world, petId)
+		if err != nil {
+			return err
+		}
+		if activity.Activity == game.ActivityBattling {
+			if err := component.StopPetActivity(world, petId, activity); err != nil {
+				return err
+			}
+		}
+	}
+	log.Info().Msgf("Battle: %d finished, winner %d", battleId, winner)
+
+	// Step 4: Emit the `battle_finished` event
+	return world.EmitEvent(map[string]any{
+		"event": "battle_finished",
+		"id":    battleId,
+	})
+}
@@ -0,0 +1,72 @@
+// Package system contains game mechanics for the Tamagotchi game.
+package system
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The `BuffSystem` function is called, which checks if the current tick is a multiple of `game.BuffTickRate`.
+ * 2. If it is, the function queries all entities that have `Pet` and `Buffs` components.
+ * 3. For each living pet, the function drops the buffs that expired.
+ * 4. The amount of every remaining buff is applied to the pet, clamped like any item effect (see `component.ApplyPetEffects`).
+ * 5. The function updates the `Buffs` component with the remaining buffs.
+ *
+ * BuffSystem applies the buffs started by items every `game.BuffTickRate` tick.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the execution of the buff system.
+ */
+func BuffSystem(world cardinal.WorldContext) error {
+	log := world.Logger()
+	// Step 1: Check if the current tick is a multiple of `game.BuffTickRate`
+	if world.CurrentTick()%game.BuffTickRate != 0 {
+		return nil
+	}
+
+	// Step 2: Query all entities that have Pet and Buffs components
+	q := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Pet](), filter.Component[component.Buffs]()))
+
+	return q.Each(world, func(petId types.EntityID) bool {
+		// Skip deceased pets
+		if component.IsPetDeceased(world, petId) {
+			return true
+		}
+
+		buffs, ok := component.GetPetBuffs(world, petId)
+		if !ok || len(buffs.Active) == 0 {
+			return true
+		}
+
+		// Step 3: Drop the expired buffs
+		active := buffs.Active[:0]
+		var effects []game.ItemEffect
+		for _, buff := range buffs.Active {
+			if buff.ExpiresTick <= world.CurrentTick() {
+				continue
+			}
+			active = append(active, buff)
+			effects = append(effects, game.ItemEffect{Stat: buff.Stat, Amount: buff.Amount})
+		}
+		buffs.Active = active
+
+		// Step 4: Apply the remaining buffs
+		if _, err := component.ApplyPetEffects(world, petId, "", effects); err != nil {
+			log.Error().Msgf("Error applying buffs for entity %v: %v", petId, err)
+			return true
+		}
+
+		// Step 5: Update the Buffs component
+		if err := cardinal.SetComponent(world, petId, buffs); err != nil {
+			log.Error().Msgf("Error updating buffs for entity %v: %v", petId, err)
+		}
+		return true
+	})
+}
@@ -0,0 +1,68 @@
+package system
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * declineAmount returns how many points of the given stat a pet loses in the current decline cycle.
+ *
+ * Code Flow:
+ * 1. Look up the decline percentage of the stat for the pet's life stage (100% for pets without a LifeStage).
+ * 2. Scale it for the pet's personality, e.g. a glutton gets hungry faster (see `game.PersonalityTraits`).
+ * 3. Scale it for the pet's disease, e.g. a pet with a cold gets tired faster (see `game.Diseases`).
+ * 4. Scale it for the time of day, e.g. energy declines faster at night (see `game.Clock`).
+ * 5. Scale it for pets parked while their owner is away, e.g. frozen on vacation or in escrow (see `game.ActivityKind`).
+ * 6. Convert the percentage into whole points, rolling the remainder so a 150% rate declines 1 or 2 points.
+ *
+ * @param world The WorldContext for the game.
+ * @param petId The ID of the pet.
+ * @param stat The stat being declined (see the `game.Stat*` constants).
+ * @return int the number of points to subtract.
+ */
+func declineAmount(world cardinal.WorldContext, petId types.EntityID, stat string) int {
+	// Step 1: Look up the decline percentage for the pet's life stage
+	percent := 100
+	if stage, err := component.GetPetLifeStage(world, petId); err == nil {
+		percent = stage.Properties().DeclineRate(stat)
+	}
+
+	// Step 2: Scale the percentage for the pet's personality
+	percent = percent * game.TraitDeclinePercent(component.GetPetTraits(world, petId), stat) / 100
+
+	// Step 3: Scale the percentage for the pet's disease
+	if sickness, ok := component.GetPetSickness(world, petId); ok {
+		percent = percent * sickness.DeclinePercent(stat) / 100
+	}
+
+	// Step 4: Scale the percentage for the time of day
+	percent = percent * game.ClockAt(world.CurrentTick()).DeclinePercent(stat) / 100
+
+	// Step 5: Scale the percentage for parked pets
+	if activity, err := component.GetPetActivity(world, petId); err == nil {
+		if kind, ok := game.ActivityByName(activity.Activity); ok && kind.Parked {
+			percent = percent * kind.DeclinePercent / 100
+		}
+	}
+
+	// Step 6: Convert the percentage into points
+	return rollPercent(world, percent)
+}
+
+/**
+ * rollPercent converts a percentage into whole points, e.g. 125 is 1 point plus 1 more point 25% of the time.
+ */
+func rollPercent(world cardinal.WorldContext, percent int) int {
+	if percent <= 0 {
+		return 0
+	}
+	points := percent / 100
+	if world.Rand().Intn(100) < percent%100 {
+		points++
+	}
+	return points
+}
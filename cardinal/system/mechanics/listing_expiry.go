@@ -0,0 +1,67 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+)
+
+/**
+ * Function Flow:
+ * 1. The `ListingExpirySystem` function queries all entities that have a `Listing` component.
+ * 2. It collects the listings whose `ExpiresTick` has been reached.
+ * 3. For each expired listing, the goods are returned to the seller and the listing is removed.
+ * 4. A `listing_expired` event is emitted for each expired listing.
+ *
+ * ListingExpirySystem takes marketplace listings that were not bought in time off the market.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the execution of the listing expiry system.
+ */
+func ListingExpirySystem(world cardinal.WorldContext) error {
+	log := world.Logger()
+	tick := world.CurrentTick()
+
+	// Step 1 and 2: Collect the expired listings
+	var expired []types.EntityID
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Listing]())).
+		Each(world, func(listingId types.EntityID) bool {
+			listing, err := cardinal.GetComponent[component.Listing](world, listingId)
+			if err != nil {
+				return true
+			}
+			if tick >= listing.ExpiresTick {
+				expired = append(expired, listingId)
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+
+	for _, listingId := range expired {
+		// Step 3: Return the goods to the seller
+		listing, err := component.GetListing(world, listingId)
+		if err != nil {
+			return err
+		}
+		if err := component.CloseListing(world, listingId, listing); err != nil {
+			return fmt.Errorf("failed to expire listing %d: %w", listingId, err)
+		}
+		log.Info().Msgf("Market: listing %d expired", listingId)
+
+		// Step 4: Emit the `listing_expired` event
+		if err := world.EmitEvent(map[string]any{
+			"event": "listing_expired",
+			"id":    listingId,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
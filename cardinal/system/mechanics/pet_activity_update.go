@@ -13,16 +13,22 @@ import (
 
 	"tamagotchi/component"
 	"tamagotchi/game"
+	"tamagotchi/system"
 )
 
 /**
  * Function Flow:
  * 1. The `ActivityDeclineSystem` function is called, which checks if the current tick is a multiple of `game.ActivityUpdateTickRate`.
  * 2. If it is, the function queries all entities that have both `Pet` and `Activity` components.
- * 3. For each entity found, the function retrieves the `Activity` component and checks if the activity is not "None".
+ * 3. For each entity found, the function retrieves the `Activity` component and checks if the activity is not "None",
+ *    nor held until something else stops it (e.g. a pet in escrow, see `game.ActivityKind`).
  * 4. If the activity is not "None", the function decrements the activity duration by one.
- * 5. If the activity duration is greater than zero, the function updates the activity percentage and sets the updated `Activity` component.
- * 6. If the activity duration reaches zero, the function resets the activity to "None" and sets the updated `Activity` component.
+ * 5. If the activity duration is greater than zero, the function applies the tick effects of the activity that are due
+ *    (see `game.ActivityKinds`, at the rate for the time of day), adds the pet's earnings to the activity, scaled by the
+ *    pet's mood (see `game.MoodLevel`) and paid to its owner in one go when the activity stops, and updates the activity
+ *    percentage. The owner of a parked pet is charged every hour instead (see `chargeParkedPet`).
+ * 6. If the activity duration reaches zero, the function completes the activity (see `completeActivity`).
+ * 7. Finally, the next queued action of every idle pet is started (see `startQueuedActivities`).
  *
  * ActivityDeclineSystem periodically decreases the duration of a pet's current activity.
  *
@@ -38,79 +44,271 @@ import (
 func ActivityDeclineSystem(world cardinal.WorldContext) error {
 	log := world.Logger()
 	// Step 1: Check if the current tick is a multiple of `game.ActivityUpdateTickRate`
-	if world.CurrentTick()%game.ActivityUpdateTickRate == 0 {
-		// Step 2: Query all entities that have both Pet and Activity components
-		q := cardinal.NewSearch().Entity(
-			filter.Contains(filter.Component[component.Pet](), filter.Component[component.Activity]()))
-
-		return q.Each(world, func(petId types.EntityID) bool {
-			// Step 3: Retrieve the Activity component for the current entity
-			activity, err := cardinal.GetComponent[component.Activity](world, petId)
+	if world.CurrentTick()%game.ActivityUpdateTickRate != 0 {
+		return nil
+	}
+
+	// Step 2: Query all entities that have both Pet and Activity components
+	clock := game.ClockAt(world.CurrentTick())
+	q := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Pet](), filter.Component[component.Activity]()))
+
+	err := q.Each(world, func(petId types.EntityID) bool {
+		// Skip deceased pets
+		if component.IsPetDeceased(world, petId) {
+			return true
+		}
+
+		// Step 3: Retrieve the Activity component and skip idle and held pets
+		activity, err := cardinal.GetComponent[component.Activity](world, petId)
+		if err != nil || activity.Activity == game.InitialActivity {
+			return true
+		}
+		kind, known := game.ActivityByName(activity.Activity)
+		if known && kind.Held {
+			return true
+		}
+		pet, err := cardinal.GetComponent[component.Pet](world, petId)
+		if err != nil {
+			return true
+		}
+
+		// Step 4: Decrement the activity duration by one
+		activity.CountDown--
+		if activity.CountDown <= 0 {
+			// Step 6: Complete the activity
+			if err := completeActivity(world, petId, pet, activity); err != nil {
+				log.Error().Msgf("Error completing activity for entity %v: %v", petId, err)
+			}
+			return true
+		}
+
+		// Step 5: Apply the tick effects that are due, less often during the day for activities such as sleep
+		elapsed := activity.TotalTicks - activity.CountDown
+		if known && kind.TickRate > 0 && elapsed%kind.TickRateAt(clock) == 0 {
+			if _, err := component.ApplyPetEffects(world, petId, kind.Name, kind.TickEffects); err != nil {
+				log.Error().Msgf("Error applying activity effects for entity %v: %v", petId, err)
+			}
+		}
+
+		// Step 5: Add the pet's earnings to the activity, paid to the `Player` when the activity stops (see
+		//         `component.StopPetActivity`). A pet in a good mood earns more, rolling the remainder as for declines
+		//         (see `rollPercent`). The owner of a parked pet pays for it at the start of every hour instead.
+		if !known || !kind.Parked {
+			activity.Earned += game.PetEarnMoney * game.Money(rollPercent(world, component.PetMoodLevel(world, petId).IncomePercent))
+		}
+
+		// Step 5: Update the activity percentage
+		if activity.TotalTicks != 0 {
+			activity.Percentage = int((float64(activity.CountDown) / float64(activity.TotalTicks)) * 100)
+		} else {
+			activity.Percentage = 0
+		}
+		if err := cardinal.SetComponent(world, petId, activity); err != nil {
+			log.Error().Msgf("Error updating activity component for entity %v: %v", petId, err)
+			return true
+		}
+
+		// Step 5: Charge the owner of a parked pet for the next hour
+		if known && kind.Parked && elapsed%game.TickHour == 0 {
+			playerId, err := component.FindPlayerByPersonaTag(world, pet.PersonaTag)
 			if err != nil {
-				// Log the error and continue processing other entities
-				// Step 3.1: Handle error during component retrieval
 				return true
 			}
-
-			// Step 4: Check if the activity is not "None"
-			if activity.Activity != "None" {
-				// Step 5: Decrement the activity duration by one
-				activity.CountDown--
-				// Decrement the activity duration if it is greater than zero
-				if activity.CountDown > 0 {
-					// Update player money
-					pet, err := cardinal.GetComponent[component.Pet](world, petId)
-					if err != nil {
-						// Log the error and continue processing other entities
-						// Step 3.1: Handle error during component retrieval
-						return true
-					}
-					playerId, err := component.FindPlayerByPersonaTag(world, pet.PersonaTag)
-					if err != nil {
-						return true
-					}
-
-					// Step 6: Update the activity percentage
-					if activity.TotalTicks != 0 {
-						activity.Percentage = int((float64(activity.CountDown) / float64(activity.TotalTicks)) * 100)
-					} else {
-						activity.Percentage = 0
-					}
-					// Step 7: Update the `Activity` component
-					if err := cardinal.SetComponent(world, petId, activity); err != nil {
-						// Log the error and continue processing other entities
-						// Step 7.1: Handle error during component update
-						log.Printf("Error updating activity component for entity %v: %v", petId, err)
-						return true
-					}
-
-					// Step 8: Update the `Player` Money component again
-					if err := component.IncreasePlayerMoney(world, playerId, game.PetEarnMoney); err != nil {
-						// Log the error and continue processing other entities
-						// Step 8.1: Handle error during component update
-						log.Printf("Error updating player component for entity %v: %v", petId, err)
-						return true
-					}
-
-				} else {
-					// Step 9: Reset the activity to "None" when duration reaches zero
-					activity.Activity = "None"
-					activity.CountDown = 0
-					activity.Percentage = 0
-					activity.TotalTicks = 0
-					// Step 10: Update the `Activity` component
-					if err := cardinal.SetComponent(world, petId, activity); err != nil {
-						// Log the error and continue processing other entities
-						// Step 10.1: Handle error during component update
-						return true
-					}
-				}
+			if err := chargeParkedPet(world, playerId, petId, pet, activity, kind); err != nil {
+				log.Error().Msgf("Error charging parked pet %v: %v", petId, err)
 			}
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+
+	// Step 7: Start the next queued actions
+	return startQueuedActivities(world)
+}
 
-			// Step 11: Continue processing other entities
+/**
+ * completeActivity ends an activity that ran to its end.
+ *
+ * Code Flow:
+ * 1. Apply the completion effects of the activity (see `game.ActivityKinds`) and the rewards held back by the action
+ *    starting it, e.g. the effects of the food eaten and the experience of playing (see `component.ActivityRewards`).
+ * 2. Set the pet back to no activity, and let the pet think about it for a while (see `game.EventThoughts`).
+ * 3. Emit an `activity_completed` event with the actual change of every stat and the experience earned.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   pet (*component.Pet): The Pet component of the pet.
+ *   activity (*component.Activity): The activity that ended.
+ *
+ * Returns:
+ *   error: An error if the pet could not be updated or the event could not be emitted.
+ */
+func completeActivity(world cardinal.WorldContext, petId types.EntityID, pet *component.Pet, activity *component.Activity) error {
+	name := activity.Activity
+
+	// Step 1: Apply the completion effects and grant the experience
+	var deltas component.StatDeltas
+	var xp int64
+	if kind, ok := game.ActivityByName(name); ok {
+		var effects []game.ItemEffect
+		effects, xp = component.ActivityRewards(activity, kind, activity.TotalTicks)
+		var err error
+		if deltas, err = component.ApplyPetEffects(world, petId, kind.Name, effects); err != nil {
+			return err
+		}
+		if err := component.GrantActivityXP(world, petId, kind.Name, xp); err != nil {
+			return err
+		}
+	}
+
+	// Step 2: Set the pet back to no activity
+	if err := component.StopPetActivity(world, petId, activity); err != nil {
+		return err
+	}
+	if err := component.NotePetEvent(world, petId, game.ActivityEvent(game.EventCompleted, name)); err != nil {
+		return err
+	}
+
+	// Step 3: Emit the `activity_completed` event
+	return world.EmitEvent(map[string]any{
+		"event":    "activity_completed",
+		"id":       petId,
+		"nickname": pet.Nickname,
+		"activity": name,
+		"effects":  deltas,
+		"xp":       xp,
+	})
+}
+
+/**
+ * chargeParkedPet charges the owner of a parked pet for the next hour, e.g. while the pet is on vacation.
+ *
+ * Code Flow:
+ * 1. Take the hourly cost of the activity from the owner, recorded as vacation in the ledger.
+ * 2. When the owner cannot pay, bring the pet home early (see `completeActivity`)
+ *    and emit a `vacation_unpaid` event.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   playerId (types.EntityID): The ID of the owner of the pet.
+ *   petId (types.EntityID): The ID of the pet.
+ *   pet (*component.Pet): The Pet component of the pet.
+ *   activity (*component.Activity): The activity parking the pet.
+ *   kind (game.ActivityKind): The kind of the activity.
+ *
+ * Returns:
+ *   error: An error if the pet could not be brought home or the event could not be emitted.
+ */
+func chargeParkedPet(world cardinal.WorldContext, playerId types.EntityID, petId types.EntityID, pet *component.Pet,
+	activity *component.Activity, kind game.ActivityKind) error {
+	// Step 1: Charge the owner
+	err := component.ReducePlayerMoney(world, playerId, kind.HourlyCost, game.LedgerVacation, pet.Nickname)
+	if err == nil {
+		return nil
+	}
+
+	// Step 2: Bring the pet home
+	world.Logger().Info().Msgf("Vacation: %s comes home, its owner cannot pay: %v", pet.Nickname, err)
+	if err := completeActivity(world, petId, pet, activity); err != nil {
+		return err
+	}
+	return world.EmitEvent(map[string]any{
+		"event":    "vacation_unpaid",
+		"id":       petId,
+		"nickname": pet.Nickname,
+		"activity": kind.Name,
+	})
+}
+
+/**
+ * startQueuedActivities starts the next queued action of every idle pet (see `component.ActivityQueue`).
+ *
+ * Code Flow:
+ * 1. Collect the living, idle pets with queued actions. Starting an action may add components to the pet,
+ *    so pets are collected before any action starts.
+ * 2. For each pet, take actions off the front of its queue until one starts,
+ *    emitting a `queued_activity_failed` event for each action that cannot start, e.g. when the item is gone.
+ * 3. Emit a `queued_activity_started` event for the action started and update the queue.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *
+ * Returns:
+ *   error: An error if the pets could not be searched or an event could not be emitted.
+ */
+func startQueuedActivities(world cardinal.WorldContext) error {
+	log := world.Logger()
+
+	// Step 1: Collect the idle pets with queued actions
+	var idle []types.EntityID
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Pet](), filter.Component[component.ActivityQueue]())).
+		Each(world, func(petId types.EntityID) bool {
+			if component.IsPetDeceased(world, petId) {
+				return true
+			}
+			queue, ok := component.GetPetQueue(world, petId)
+			if !ok || len(queue.Pending) == 0 {
+				return true
+			}
+			activity, err := component.GetPetActivity(world, petId)
+			if err != nil || activity.CountDown > 0 {
+				return true
+			}
+			idle = append(idle, petId)
 			return true
 		})
+	if err != nil {
+		return err
+	}
+
+	for _, petId := range idle {
+		pet, err := cardinal.GetComponent[component.Pet](world, petId)
+		if err != nil {
+			continue
+		}
+		queue, _ := component.GetPetQueue(world, petId)
+
+		// Step 2: Start the first action that can start
+		for len(queue.Pending) > 0 {
+			next := queue.Pending[0]
+			queue.Pending = queue.Pending[1:]
+
+			activity, err := system.StartQueuedActivity(world, pet.PersonaTag, pet.Nickname, next)
+			if err != nil {
+				log.Info().Msgf("Queue: %s could not start %s: %v", pet.Nickname, next.Action, err)
+				if err := world.EmitEvent(map[string]any{
+					"event":    "queued_activity_failed",
+					"id":       petId,
+					"nickname": pet.Nickname,
+					"action":   next.Action,
+					"error":    err.Error(),
+				}); err != nil {
+					return err
+				}
+				continue
+			}
+
+			// Step 3: Emit the `queued_activity_started` event
+			if err := world.EmitEvent(map[string]any{
+				"event":    "queued_activity_started",
+				"id":       petId,
+				"nickname": pet.Nickname,
+				"action":   next.Action,
+				"activity": activity.Activity,
+				"duration": activity.CountDown,
+			}); err != nil {
+				return err
+			}
+			break
+		}
+		if err := component.SetPetQueue(world, petId, queue); err != nil {
+			log.Error().Msgf("Error updating activity queue for entity %v: %v", petId, err)
+		}
 	}
-	// Step 12: Return nil if the current tick is not a multiple of `game.ActivityUpdateTickRate`
 	return nil
 }
@@ -0,0 +1,93 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * KillPet marks a pet as deceased, moves it to its owner's graveyard and emits a `pet_died` event.
+ *
+ * Code Flow:
+ * 1. Add the Deceased component with the cause of death.
+ * 2. Stop any activity the pet was engaged in.
+ * 3. Move the pet from the owner's Pets to the owner's Graveyard.
+ * 4. Emit a `pet_died` event with the cause.
+ *
+ * @param world The WorldContext for the game.
+ * @param petId The ID of the pet.
+ * @param cause The cause of death (see the `game.Death*` constants).
+ * @return error if any error occurs while killing the pet.
+ */
+func KillPet(world cardinal.WorldContext, petId types.EntityID, cause string) error {
+	pet, err := cardinal.GetComponent[component.Pet](world, petId)
+	if err != nil {
+		return fmt.Errorf("failed to kill pet [get Pet]: %w", err)
+	}
+
+	// Step 1: Add the Deceased component
+	if err := component.MarkPetDeceased(world, petId, cause); err != nil {
+		return err
+	}
+
+	// Step 2: Stop any activity
+	if activity, err := cardinal.GetComponent[component.Activity](world, petId); err == nil {
+		if err := component.StopPetActivity(world, petId, activity); err != nil {
+			return err
+		}
+	}
+
+	// Step 3: Move the pet to the owner's graveyard
+	playerID, err := component.FindPlayerByPersonaTag(world, pet.PersonaTag)
+	if err != nil {
+		return fmt.Errorf("failed to kill pet [find owner]: %w", err)
+	}
+	if err := component.BuryPlayerPet(world, playerID, petId); err != nil {
+		return err
+	}
+	world.Logger().Info().Msgf("Death: Pet[%s] died of %s", pet.Nickname, cause)
+
+	// Step 4: Emit the `pet_died` event
+	return world.EmitEvent(map[string]any{
+		"event": "pet_died",
+		"id":    petId,
+		"cause": cause,
+	})
+}
+
+/**
+ * healthDeclineCause returns why a pet is losing health, or an empty string if it is not.
+ *
+ * Code Flow:
+ * 1. A starving pet (satiety at or below `game.StarvationThreshold`) loses health from starvation.
+ * 2. A dirty pet (hygiene at or below `game.HygieneThreshold`) loses health from poor hygiene.
+ * 3. A pet with no wellness left loses health from neglect.
+ * 4. A pet whose disease reached `game.MaxSeverity` loses health from the disease.
+ */
+func healthDeclineCause(world cardinal.WorldContext, petId types.EntityID, hygiene *component.Hygiene) string {
+	// Step 1: Starvation
+	if hunger, err := cardinal.GetComponent[component.Hunger](world, petId); err == nil && hunger.Satiety <= game.StarvationThreshold {
+		return game.DeathStarvation
+	}
+
+	// Step 2: Poor hygiene
+	if hygiene.Hy <= game.HygieneThreshold {
+		return game.DeathHygiene
+	}
+
+	// Step 3: Neglect
+	if wellness, err := cardinal.GetComponent[component.Wellness](world, petId); err == nil && wellness.Wn <= 0 {
+		return game.DeathNeglect
+	}
+
+	// Step 4: Disease
+	if sickness, ok := component.GetPetSickness(world, petId); ok && sickness.Disease != "" && sickness.Severity >= game.MaxSeverity {
+		return game.DeathDisease
+	}
+	return ""
+}
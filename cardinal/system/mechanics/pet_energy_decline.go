@@ -41,6 +41,11 @@ func EnergyDeclineSystem(world cardinal.WorldContext) error {
 		return q.
 			// Step 3: For each entity found, retrieve the Energy component
 			Each(world, func(id types.EntityID) bool {
+				// Skip deceased pets
+				if component.IsPetDeceased(world, id) {
+					return true
+				}
+
 				energy, err := cardinal.GetComponent[component.Energy](world, id)
 				if err != nil {
 					// Step 3.1: Handle error during component retrieval
@@ -49,10 +54,8 @@ func EnergyDeclineSystem(world cardinal.WorldContext) error {
 
 				// Step 4: Log the energy value before the decrement operation
 				log.Info().Msgf("Energy Decline: Energy Before[%d]", energy.E)
-				// Step 5: Decrement the energy value if it is greater than zero
-				if energy.E > 0 {
-					energy.E--
-				}
+				// Step 5: Decrement the energy value by the life stage decline rate, without going below zero
+				energy.E = max(energy.E-declineAmount(world, id, game.StatEnergy), 0)
 				// Step 6: Log the energy value after the decrement operation
 				log.Info().Msgf("Energy Decline: Energy After[%d]", energy.E)
 
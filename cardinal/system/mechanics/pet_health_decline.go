@@ -14,15 +14,17 @@ import (
  * 1. The `HealthDeclineSystem` function is called, which checks if the current tick is a multiple of `game.DeclineTickRate`.
  * 2. If it is, the function queries all entities that have `Pet`, `Health`, and `Hygiene` components.
  * 3. For each entity found, the function retrieves the `Hygiene` component and checks if the hygiene value is less than or equal to `game.HygieneThreshold`.
- * 4. If the hygiene value is less than or equal to `game.HygieneThreshold`, the function retrieves the `Health` component and checks if the health value is greater than zero.
- * 5. If the health value is greater than zero, the function decrements the health value by one.
- * 6. The function updates the `Health` component with the new health value.
+ * 4. If the pet is starving (satiety at or below `game.StarvationThreshold`), dirty (hygiene at or below `game.HygieneThreshold`)
+ *    or neglected (no wellness left), the function retrieves the `Health` component.
+ * 5. The function decrements the health value by the life stage decline rate, without going below zero.
+ * 6. The function updates the `Health` component with the new health value, and kills the pet (see `KillPet`) when it reaches zero.
  * 7. If any error occurs during the execution of the health decline system, the function logs the error and continues processing other entities.
  *
  * HealthDeclineSystem declines the pet's Hy every `HealthDeclineTicksPerSecond` tick.
  *
  * This system iterates over all entities that have `Pet`, `Health`, and `Hygiene` components,
- * reducing the health value by one each time it is processed if the hygiene value is less than or equal to `game.HygieneThreshold`.
+ * reducing the health value each time it is processed if the pet is starving, the hygiene value is less than or equal
+ * to `game.HygieneThreshold`, or the pet is neglected (no wellness left). A pet whose health reaches zero dies of that cause.
  *
  * The function returns an error if there is a failure during component access or update.
  *
@@ -43,36 +45,53 @@ func HealthDeclineSystem(world cardinal.WorldContext) error {
 				filter.Component[component.Hygiene](),
 			))
 
-		return q.
+		var killErr error
+		err := q.
 			// Step 3: For each entity found, retrieve the Hygiene component
 			Each(world, func(id types.EntityID) bool {
+				// Skip deceased pets
+				if component.IsPetDeceased(world, id) {
+					return true
+				}
+
 				hygiene, err := cardinal.GetComponent[component.Hygiene](world, id)
 				if err != nil {
 					// Step 3.1: Handle error during component retrieval
 					return true
 				}
 
-				// Step 4: Check if the hygiene value is less than or equal to `game.HygieneThreshold`
-				if hygiene.Hy <= game.HygieneThreshold {
+				// Step 4: Check if the pet is losing health (starvation, poor hygiene or neglect)
+				cause := healthDeclineCause(world, id, hygiene)
+				if cause != "" {
 					health, err := cardinal.GetComponent[component.Health](world, id)
 					if err != nil {
 						// Step 4.1: Handle error during component retrieval
 						return true
 					}
-					// Step 5: Check if the health value is greater than zero and decrement it by one
-					if health.HP > 0 {
-						health.HP--
-					}
+					// Step 5: Decrement the health value by the life stage decline rate, without going below zero
+					health.HP = max(health.HP-declineAmount(world, id, game.StatHealth), 0)
 
 					// Step 6: Update the Health component with the new health value
 					if err := cardinal.SetComponent(world, id, health); err != nil {
 						// Step 6.1: Handle error during component update
 						return true
 					}
+
+					// Step 6.2: The pet dies when its health reaches zero
+					if health.HP == 0 {
+						if err := KillPet(world, id, cause); err != nil {
+							killErr = err
+							return false
+						}
+					}
 				}
 				// Step 7: Continue processing other entities
 				return true
 			})
+		if err != nil {
+			return err
+		}
+		return killErr
 	} else {
 		// Step 8: Return nil if the current tick is not a multiple of `game.DeclineTickRate`
 		return nil
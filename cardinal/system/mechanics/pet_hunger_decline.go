@@ -0,0 +1,63 @@
+// Package system contains game mechanics for the Tamagotchi game.
+package system
+
+import (
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The `HungerDeclineSystem` function is called, which checks if the current tick is a multiple of `game.DeclineTickRate`.
+ * 2. If it is, the function queries all entities that have `Pet` and `Hunger` components.
+ * 3. For each entity found, the function retrieves the `Hunger` component and checks its current satiety.
+ * 4. The function decrements the satiety by the life stage decline rate, without going below zero.
+ * 5. The function updates the `Hunger` component with the new satiety.
+ *
+ * HungerDeclineSystem makes the pet hungrier every `game.DeclineTickRate` tick.
+ *
+ * A pet whose satiety drops to `game.StarvationThreshold` or below starts losing health in `HealthDeclineSystem`.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the execution of the hunger decline system.
+ */
+func HungerDeclineSystem(world cardinal.WorldContext) error {
+	// Step 1: Check if the current tick is a multiple of `game.DeclineTickRate`
+	if world.CurrentTick()%game.DeclineTickRate != 0 {
+		return nil
+	}
+
+	// Step 2: Query all entities that have Pet and Hunger components
+	q := cardinal.NewSearch().Entity(
+		filter.Contains(
+			filter.Component[component.Pet](),
+			filter.Component[component.Hunger]()))
+
+	return q.
+		// Step 3: For each entity found, retrieve the Hunger component
+		Each(world, func(id types.EntityID) bool {
+			// Skip deceased pets
+			if component.IsPetDeceased(world, id) {
+				return true
+			}
+
+			hunger, err := cardinal.GetComponent[component.Hunger](world, id)
+			if err != nil {
+				// Step 3.1: Handle error during component retrieval
+				return true
+			}
+			// Step 4: Decrement the satiety by the life stage decline rate, without going below zero
+			hunger.Satiety = max(hunger.Satiety-declineAmount(world, id, game.StatSatiety), 0)
+
+			// Step 5: Update the Hunger component with the new satiety
+			if err := cardinal.SetComponent(world, id, hunger); err != nil {
+				// Step 5.1: Handle error during component update
+				return true
+			}
+			return true
+		})
+}
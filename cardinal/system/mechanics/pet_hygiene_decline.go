@@ -43,15 +43,18 @@ func HygieneDeclineSystem(world cardinal.WorldContext) error {
 		return q.
 			// Step 3: For each entity found, retrieve the Hygiene component
 			Each(world, func(id types.EntityID) bool {
+				// Skip deceased pets
+				if component.IsPetDeceased(world, id) {
+					return true
+				}
+
 				hygiene, err := cardinal.GetComponent[component.Hygiene](world, id)
 				if err != nil {
 					// Step 3.1: Handle error during component retrieval
 					return true
 				}
-				// Step 4: Check if the hygiene value is greater than zero and decrement it by one
-				if hygiene.Hy > 0 {
-					hygiene.Hy--
-				}
+				// Step 4: Decrement the hygiene value by the life stage decline rate, without going below zero
+				hygiene.Hy = max(hygiene.Hy-declineAmount(world, id, game.StatHygiene), 0)
 
 				// Step 5: Update the Hygiene component with the new hygiene value
 				if err := cardinal.SetComponent(world, id, hygiene); err != nil {
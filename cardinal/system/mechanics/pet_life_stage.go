@@ -0,0 +1,92 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The `LifeStageSystem` function is called, which checks if the current tick is a multiple of `game.LifeStageTickRate`.
+ * 2. If it is, the function queries all entities that have a `Pet` component.
+ * 3. For each pet, the function computes the stage matching its age (ticks since `Pet.BornTick`).
+ * 4. Pets without a `LifeStage` component (created before life stages existed) get one.
+ * 5. If the computed stage is older than the current one, the pet advances and a `stage_changed` event is emitted.
+ *
+ * LifeStageSystem moves pets through egg, baby, child, teen, adult and elder as they age.
+ *
+ * Stages only ever move forward, so a pet never becomes younger because of a change in the thresholds.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the execution of the life stage system.
+ */
+func LifeStageSystem(world cardinal.WorldContext) error {
+	// Step 1: Check if the current tick is a multiple of `game.LifeStageTickRate`
+	if world.CurrentTick()%game.LifeStageTickRate != 0 {
+		return nil
+	}
+	log := world.Logger()
+
+	// Step 2: Query all entities that have a Pet component
+	var updateErr error
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Pet]())).
+		Each(world, func(petId types.EntityID) bool {
+			// Skip deceased pets
+			if component.IsPetDeceased(world, petId) {
+				return true
+			}
+
+			pet, err := cardinal.GetComponent[component.Pet](world, petId)
+			if err != nil {
+				return true
+			}
+
+			// Step 3: Compute the stage matching the pet's age
+			expected := game.StageForAge(component.PetAge(world, pet))
+
+			// Step 4: Add a LifeStage to pets that do not have one yet
+			stage, err := component.GetPetLifeStage(world, petId)
+			if err != nil {
+				if err := cardinal.AddComponentTo[component.LifeStage](world, petId); err != nil {
+					updateErr = fmt.Errorf("failed to add [LifeStage] to pet %d: %w", petId, err)
+					return false
+				}
+				stage = &component.LifeStage{Stage: game.StageEgg, Since: pet.BornTick}
+			}
+
+			// Step 5: Advance the pet if it is older than its current stage
+			if game.StageIndex(expected.Name) <= game.StageIndex(stage.Stage) {
+				return true
+			}
+			previous := stage.Stage
+			stage.Stage = expected.Name
+			stage.Since = world.CurrentTick()
+			if err := cardinal.SetComponent(world, petId, stage); err != nil {
+				updateErr = fmt.Errorf("failed to set [LifeStage]: %w", err)
+				return false
+			}
+			log.Info().Msgf("Life Stage: Pet[%s] %s -> %s", pet.Nickname, previous, stage.Stage)
+
+			if err := world.EmitEvent(map[string]any{
+				"event":    "stage_changed",
+				"id":       petId,
+				"stage":    stage.Stage,
+				"previous": previous,
+			}); err != nil {
+				updateErr = err
+				return false
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+	return updateErr
+}
@@ -0,0 +1,148 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The `MoodSystem` function is called, which checks if the current tick is a multiple of `game.MoodTickRate`.
+ * 2. If it is, the function queries all entities that have `Pet`, `Health`, `Energy`, `Hygiene` and `Wellness` components.
+ * 3. For each living pet, the function scores its stats with `game.MoodWeights` and picks the mood of the score.
+ * 4. Pets keeping their mood only get their score refreshed.
+ * 5. Once every pet has been checked, the function sets the new moods and emits a `mood_changed` event for each.
+ *    Pets created before moods existed get the Mood component, which is why it does not happen during the search.
+ *
+ * MoodSystem sums up how each pet is doing after its stats declined, see `component.Mood`.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the execution of the mood system.
+ */
+func MoodSystem(world cardinal.WorldContext) error {
+	// Step 1: Check if the current tick is a multiple of `game.MoodTickRate`
+	if world.CurrentTick()%game.MoodTickRate != 0 {
+		return nil
+	}
+	log := world.Logger()
+
+	// Step 2: Query all entities that have a Pet and the stats the mood is derived from
+	q := cardinal.NewSearch().Entity(
+		filter.Contains(
+			filter.Component[component.Pet](),
+			filter.Component[component.Health](),
+			filter.Component[component.Energy](),
+			filter.Component[component.Hygiene](),
+			filter.Component[component.Wellness]()))
+
+	type moodChange struct {
+		petId    types.EntityID
+		nickname string
+		mood     component.Mood
+		previous string
+		missing  bool
+	}
+	var changes []moodChange
+	var updateErr error
+	err := q.Each(world, func(petId types.EntityID) bool {
+		// Skip deceased pets
+		if component.IsPetDeceased(world, petId) {
+			return true
+		}
+		pet, err := cardinal.GetComponent[component.Pet](world, petId)
+		if err != nil {
+			return true
+		}
+
+		// Step 3: Score the stats and pick the mood
+		score, err := petMoodScore(world, petId)
+		if err != nil {
+			return true
+		}
+		mood := component.NewMood(score, world.CurrentTick())
+
+		// Step 4: Refresh the score of pets keeping their mood
+		current, ok := component.GetPetMood(world, petId)
+		if ok && current.Mood == mood.Mood {
+			if current.Score != score {
+				current.Score = score
+				if err := cardinal.SetComponent(world, petId, current); err != nil {
+					updateErr = fmt.Errorf("failed to set [Mood]: %w", err)
+					return false
+				}
+			}
+			return true
+		}
+		change := moodChange{petId: petId, nickname: pet.Nickname, mood: mood, missing: !ok}
+		if ok {
+			change.previous = current.Mood
+		}
+		changes = append(changes, change)
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if updateErr != nil {
+		return updateErr
+	}
+
+	// Step 5: Set the new moods and emit the `mood_changed` events
+	for _, change := range changes {
+		if change.missing {
+			if err := cardinal.AddComponentTo[component.Mood](world, change.petId); err != nil {
+				return fmt.Errorf("failed to add [Mood] to pet %d: %w", change.petId, err)
+			}
+		}
+		if err := cardinal.SetComponent(world, change.petId, &change.mood); err != nil {
+			return fmt.Errorf("failed to set [Mood]: %w", err)
+		}
+		log.Info().Msgf("Mood: Pet[%s] %s -> %s", change.nickname, change.previous, change.mood.Mood)
+
+		if err := world.EmitEvent(map[string]any{
+			"event":    "mood_changed",
+			"id":       change.petId,
+			"nickname": change.nickname,
+			"mood":     change.mood.Mood,
+			"previous": change.previous,
+			"score":    change.mood.Score,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * petMoodScore scores the stats of a pet, see `game.MoodScore`.
+ */
+func petMoodScore(world cardinal.WorldContext, petId types.EntityID) (int, error) {
+	health, err := cardinal.GetComponent[component.Health](world, petId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to score mood [get Health]: %w", err)
+	}
+	energy, err := cardinal.GetComponent[component.Energy](world, petId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to score mood [get Energy]: %w", err)
+	}
+	hygiene, err := cardinal.GetComponent[component.Hygiene](world, petId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to score mood [get Hygiene]: %w", err)
+	}
+	wellness, err := cardinal.GetComponent[component.Wellness](world, petId)
+	if err != nil {
+		return 0, fmt.Errorf("failed to score mood [get Wellness]: %w", err)
+	}
+	return game.MoodScore(map[string]int{
+		game.StatHealth:   health.HP,
+		game.StatEnergy:   energy.E,
+		game.StatHygiene:  hygiene.Hy,
+		game.StatWellness: wellness.Wn,
+	}), nil
+}
@@ -0,0 +1,169 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The `SicknessSystem` function is called, which checks if the current tick is a multiple of `game.SicknessTickRate`.
+ * 2. If it is, the function queries all entities that have `Pet`, `Health` and `Hygiene` components.
+ * 3. For each sick pet, the function raises the severity of its disease every `ProgressTicks` of the disease,
+ *    up to `game.MaxSeverity`, and emits a `sickness_worsened` event.
+ * 4. For each healthy pet but eggs, each stat below `game.SicknessThreshold` rolls `game.SicknessChance`:
+ *    on a hit the pet catches one of the diseases of the stat it is not immune to.
+ * 5. Once every pet has been checked, the function infects the pets that fell sick and emits a `pet_sick` event for each.
+ *    Pets falling sick for the first time get the Sickness component, which is why it does not happen during the search.
+ *
+ * SicknessSystem makes neglected pets fall sick and their diseases get worse until they are cured (see `component.TreatPet`).
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the execution of the sickness system.
+ */
+func SicknessSystem(world cardinal.WorldContext) error {
+	// Step 1: Check if the current tick is a multiple of `game.SicknessTickRate`
+	if world.CurrentTick()%game.SicknessTickRate != 0 {
+		return nil
+	}
+	log := world.Logger()
+
+	// Step 2: Query all entities that have Pet, Health and Hygiene components
+	q := cardinal.NewSearch().Entity(
+		filter.Contains(
+			filter.Component[component.Pet](),
+			filter.Component[component.Health](),
+			filter.Component[component.Hygiene]()))
+
+	type infection struct {
+		petId    types.EntityID
+		nickname string
+		disease  string
+	}
+	var infections []infection
+	var updateErr error
+	err := q.Each(world, func(petId types.EntityID) bool {
+		// Skip deceased pets
+		if component.IsPetDeceased(world, petId) {
+			return true
+		}
+		pet, err := cardinal.GetComponent[component.Pet](world, petId)
+		if err != nil {
+			return true
+		}
+
+		// Step 3: Make the disease of sick pets worse
+		sickness, hasSickness := component.GetPetSickness(world, petId)
+		if hasSickness && sickness.Disease != "" {
+			disease, ok := sickness.Properties()
+			if !ok || disease.ProgressTicks == 0 {
+				return true
+			}
+			severity := min(1+int((world.CurrentTick()-sickness.Since)/disease.ProgressTicks), game.MaxSeverity)
+			if severity <= sickness.Severity {
+				return true
+			}
+			sickness.Severity = severity
+			if err := cardinal.SetComponent(world, petId, sickness); err != nil {
+				updateErr = fmt.Errorf("failed to set [Sickness]: %w", err)
+				return false
+			}
+			log.Info().Msgf("Sickness: Pet[%s] %s got worse (%d/%d)", pet.Nickname, disease.Name, severity, game.MaxSeverity)
+			if err := world.EmitEvent(map[string]any{
+				"event":    "sickness_worsened",
+				"id":       petId,
+				"nickname": pet.Nickname,
+				"disease":  disease.Name,
+				"severity": severity,
+			}); err != nil {
+				updateErr = err
+				return false
+			}
+			return true
+		}
+
+		// Step 4: Roll for the diseases of the low stats, eggs do not fall sick
+		if stage, err := component.GetPetLifeStage(world, petId); err == nil && stage.Stage == game.StageEgg {
+			return true
+		}
+		if disease := catchDisease(world, petId, sickness); disease != "" {
+			infections = append(infections, infection{petId: petId, nickname: pet.Nickname, disease: disease})
+		}
+		return true
+	})
+	if err != nil {
+		return err
+	}
+	if updateErr != nil {
+		return updateErr
+	}
+
+	// Step 5: Infect the pets that fell sick and emit the `pet_sick` events
+	for _, infected := range infections {
+		if err := component.InfectPet(world, infected.petId, infected.disease); err != nil {
+			return err
+		}
+		log.Info().Msgf("Sickness: Pet[%s] caught %s", infected.nickname, infected.disease)
+		if err := world.EmitEvent(map[string]any{
+			"event":    "pet_sick",
+			"id":       infected.petId,
+			"nickname": infected.nickname,
+			"disease":  infected.disease,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/**
+ * catchDisease rolls for the diseases caught from the low stats of a healthy pet.
+ *
+ * Code Flow:
+ * 1. For hygiene then health, roll the chance to fall sick of the stat (see `game.SicknessChance`).
+ * 2. On a hit, pick one of the diseases caught from the stat, leaving out the classes the pet is immune to.
+ *
+ * @param world The WorldContext for the game.
+ * @param petId The ID of the pet.
+ * @param sickness The Sickness component of the pet, nil if it never had one.
+ * @return string the name of the disease caught, empty if the pet stays healthy.
+ */
+func catchDisease(world cardinal.WorldContext, petId types.EntityID, sickness *component.Sickness) string {
+	stats := make(map[string]int)
+	if hygiene, err := cardinal.GetComponent[component.Hygiene](world, petId); err == nil {
+		stats[game.StatHygiene] = hygiene.Hy
+	}
+	if health, err := cardinal.GetComponent[component.Health](world, petId); err == nil {
+		stats[game.StatHealth] = health.HP
+	}
+
+	rng := world.Rand()
+	for _, stat := range []string{game.StatHygiene, game.StatHealth} {
+		// Step 1: Roll the chance of the stat
+		value, ok := stats[stat]
+		if !ok {
+			continue
+		}
+		if chance := game.SicknessChance(value); chance == 0 || rng.Intn(100) >= chance {
+			continue
+		}
+
+		// Step 2: Pick a disease the pet is not immune to
+		var diseases []string
+		for _, name := range game.DiseasesCausedBy(stat) {
+			if sickness == nil || !sickness.IsImmune(game.Diseases[name].Class, world.CurrentTick()) {
+				diseases = append(diseases, name)
+			}
+		}
+		if len(diseases) > 0 {
+			return diseases[rng.Intn(len(diseases))]
+		}
+	}
+	return ""
+}
@@ -42,15 +42,18 @@ func WellnessDeclineSystem(world cardinal.WorldContext) error {
 		return q.
 			// Step 3: For each entity found, retrieve the Wellness component and check its current wellness value (Wn)
 			Each(world, func(id types.EntityID) bool {
+				// Skip deceased pets
+				if component.IsPetDeceased(world, id) {
+					return true
+				}
+
 				wellness, err := cardinal.GetComponent[component.Wellness](world, id)
 				if err != nil {
 					// Step 3.1: Handle error during component retrieval
 					return true
 				}
-				// Step 4: If the wellness value is greater than 0, decrement the wellness value by 1
-				if wellness.Wn > 0 {
-					wellness.Wn--
-				}
+				// Step 4: Decrement the wellness value by the life stage decline rate, without going below zero
+				wellness.Wn = max(wellness.Wn-declineAmount(world, id, game.StatWellness), 0)
 
 				// Step 5: Update the Wellness component with the new wellness value
 				if err := cardinal.SetComponent(world, id, wellness); err != nil {
@@ -0,0 +1,70 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/filter"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+/**
+ * Function Flow:
+ * 1. The `TradeExpirySystem` function queries all entities that have a `Trade` component.
+ * 2. It collects the trades whose `ExpiresTick` has been reached.
+ * 3. For each expired trade, the offer is returned to the proposer and the trade is removed.
+ * 4. A `trade_expired` event is emitted for each expired trade.
+ *
+ * TradeExpirySystem returns the escrow of offers that were not accepted in time.
+ *
+ * Expired trades are collected before they are closed, so entities are not removed while the search is iterating.
+ *
+ * @param world The WorldContext for the game.
+ * @return error if any error occurs during the execution of the trade expiry system.
+ */
+func TradeExpirySystem(world cardinal.WorldContext) error {
+	log := world.Logger()
+	tick := world.CurrentTick()
+
+	// Step 1 and 2: Collect the expired trades
+	var expired []types.EntityID
+	err := cardinal.NewSearch().Entity(
+		filter.Contains(filter.Component[component.Trade]())).
+		Each(world, func(tradeId types.EntityID) bool {
+			trade, err := cardinal.GetComponent[component.Trade](world, tradeId)
+			if err != nil {
+				return true
+			}
+			if tick >= trade.ExpiresTick {
+				expired = append(expired, tradeId)
+			}
+			return true
+		})
+	if err != nil {
+		return err
+	}
+
+	for _, tradeId := range expired {
+		// Step 3: Return the offer to the proposer
+		trade, err := component.GetTrade(world, tradeId)
+		if err != nil {
+			return err
+		}
+		if err := component.CloseTrade(world, tradeId, trade, game.LedgerRefund); err != nil {
+			return fmt.Errorf("failed to expire trade %d: %w", tradeId, err)
+		}
+		log.Info().Msgf("Trade: trade %d expired", tradeId)
+
+		// Step 4: Emit the `trade_expired` event
+		if err := world.EmitEvent(map[string]any{
+			"event": "trade_expired",
+			"id":    tradeId,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -76,3 +76,46 @@ func CheckItemExistence(world cardinal.WorldContext, itemName string) (types.Ent
 	}
 	return itemId, nil
 }
+
+/**
+ * CheckPetAlive checks that the pet has not died.
+ *
+ * Code Flow:
+ * 1. Fetch the pet's Deceased component, if any.
+ * 2. Return an error with the cause of death if the pet is deceased.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   error: An error if the pet is deceased.
+ */
+func CheckPetAlive(world cardinal.WorldContext, petId types.EntityID) error {
+	if deceased, ok := component.GetPetDeceased(world, petId); ok {
+		return fmt.Errorf("pet is deceased [died of %s at tick %d]", deceased.Cause, deceased.DiedTick)
+	}
+	return nil
+}
+
+/**
+ * CheckPetFitFor checks that the pet is not too sick for an activity (see `game.DiseaseProperties.Blocks`).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *   activity (string): The activity the pet is about to start.
+ *
+ * Returns:
+ *   error: An error naming the disease if the pet is too sick for the activity.
+ */
+func CheckPetFitFor(world cardinal.WorldContext, petId types.EntityID, activity string) error {
+	sickness, ok := component.GetPetSickness(world, petId)
+	if !ok {
+		return nil
+	}
+	if disease, ok := sickness.Properties(); ok && disease.BlocksActivity(activity) {
+		return fmt.Errorf("pet is too sick for %s [%s]", activity, disease.Name)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+// Thought is the most urgent thought of a pet, see `game.UrgentThought`.
+type Thought struct {
+	Need    string
+	Urgency int
+	Text    string
+}
+
+/**
+ * PetThoughtState gathers what the thoughts of a pet are about (see `game.ThoughtState`).
+ *
+ * Code Flow:
+ * 1. Read the stats of the pet. Pets without a Hunger component have no satiety to think about.
+ * 2. Read the hour of the day and the event the pet is still thinking about.
+ * 3. Read the traits of the pet: its personality traits first, then its life stage and its element.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (game.ThoughtState, error): The state of the pet, and an error if its stats could not be read.
+ */
+func PetThoughtState(world cardinal.WorldContext, petId types.EntityID) (game.ThoughtState, error) {
+	// Step 1: Read the stats
+	health, err := cardinal.GetComponent[component.Health](world, petId)
+	if err != nil {
+		return game.ThoughtState{}, fmt.Errorf("failed to think [get Health]: %w", err)
+	}
+	energy, err := cardinal.GetComponent[component.Energy](world, petId)
+	if err != nil {
+		return game.ThoughtState{}, fmt.Errorf("failed to think [get Energy]: %w", err)
+	}
+	hygiene, err := cardinal.GetComponent[component.Hygiene](world, petId)
+	if err != nil {
+		return game.ThoughtState{}, fmt.Errorf("failed to think [get Hygiene]: %w", err)
+	}
+	wellness, err := cardinal.GetComponent[component.Wellness](world, petId)
+	if err != nil {
+		return game.ThoughtState{}, fmt.Errorf("failed to think [get Wellness]: %w", err)
+	}
+	state := game.ThoughtState{Stats: map[string]int{
+		game.StatHealth:   health.HP,
+		game.StatEnergy:   energy.E,
+		game.StatHygiene:  hygiene.Hy,
+		game.StatWellness: wellness.Wn,
+	}}
+	if hunger, err := cardinal.GetComponent[component.Hunger](world, petId); err == nil {
+		state.Stats[game.StatSatiety] = hunger.Satiety
+	}
+
+	// Step 2: Read the time of day and the recent event
+	state.Hour = game.ClockAt(world.CurrentTick()).Hour
+	if thoughts, ok := component.GetPetThoughts(world, petId); ok {
+		state.Event = thoughts.RecentEvent(world.CurrentTick())
+	}
+
+	// Step 3: Read the traits
+	state.Traits = append(state.Traits, component.GetPetTraits(world, petId)...)
+	if stage, err := component.GetPetLifeStage(world, petId); err == nil {
+		state.Traits = append(state.Traits, stage.Stage)
+	}
+	if magic, err := cardinal.GetComponent[component.Magic](world, petId); err == nil {
+		state.Traits = append(state.Traits, magic.Kind)
+	}
+	return state, nil
+}
+
+// Need is the most pressing need of a pet, see `game.UrgentNeed`.
+type Need struct {
+	Stat        string
+	Value       int
+	Explanation string
+}
+
+/**
+ * PetNeed returns the most pressing need of a pet, with an explanation for its owner.
+ *
+ * Returns:
+ *   (*Need, error): The need, nil when the pet needs nothing, and an error if the pet could not be read.
+ */
+func PetNeed(world cardinal.WorldContext, petId types.EntityID) (*Need, error) {
+	pet, err := cardinal.GetComponent[component.Pet](world, petId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to think [get Pet]: %w", err)
+	}
+	state, err := PetThoughtState(world, petId)
+	if err != nil {
+		return nil, err
+	}
+	rule, ok := game.UrgentNeed(state)
+	if !ok {
+		return nil, nil
+	}
+	return &Need{
+		Stat:        rule.Need,
+		Value:       state.Stats[rule.Need],
+		Explanation: game.FormatThought(rule.Explanation, pet.Nickname, pet.PersonaTag),
+	}, nil
+}
+
+/**
+ * PetThought picks the most urgent thought of a pet and fills in its template.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   petId (types.EntityID): The ID of the pet.
+ *
+ * Returns:
+ *   (*Thought, error): The thought, nil when nothing is on the pet's mind, and an error if the pet could not be read.
+ */
+func PetThought(world cardinal.WorldContext, petId types.EntityID) (*Thought, error) {
+	pet, err := cardinal.GetComponent[component.Pet](world, petId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to think [get Pet]: %w", err)
+	}
+	state, err := PetThoughtState(world, petId)
+	if err != nil {
+		return nil, err
+	}
+	rule, ok := game.UrgentThought(state)
+	if !ok {
+		return nil, nil
+	}
+	text := pickThoughtText(world, petId, rule.TextsFor(state.Traits), pet)
+	return &Thought{Need: rule.Need, Urgency: rule.Urgency, Text: text}, nil
+}
+
+// pickThoughtText fills in one of the texts at random, keeping the pet's current thought when it is one of them
+// so the pet does not change its mind on every think cycle.
+func pickThoughtText(world cardinal.WorldContext, petId types.EntityID, texts []string, pet *component.Pet) string {
+	if think, err := component.GetPetThink(world, petId); err == nil {
+		for _, text := range texts {
+			if text := game.FormatThought(text, pet.Nickname, pet.PersonaTag); text == think.Think {
+				return text
+			}
+		}
+	}
+	return game.FormatThought(texts[world.Rand().Intn(len(texts))], pet.Nickname, pet.PersonaTag)
+}
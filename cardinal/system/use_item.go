@@ -0,0 +1,234 @@
+package system
+
+import (
+	"fmt"
+
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+)
+
+// ItemUse describes how an item is used on a pet: the kind of item it takes, the activity it starts and
+// the side effects of the action itself, on top of the effects of the item.
+type ItemUse struct {
+	verb        string            // Used in error messages, e.g. "eat"
+	stat        string            // The item must have an effect on this stat, e.g. `game.StatSatiety` for food
+	activity    string            // Activity started by the use (see `game.ActivityKinds`), empty when the item is used instantly
+	xp          int64             // Experience earned by the pet
+	sideEffects []game.ItemEffect // Effects of the action, e.g. the energy spent playing
+}
+
+// How the feed, cure, bath and play actions use their item.
+var (
+	FeedUse = ItemUse{verb: "eat", stat: game.StatSatiety, activity: game.ActivityEating}
+	CureUse = ItemUse{verb: "cure", stat: game.StatHealth}
+	BathUse = ItemUse{verb: "bath", stat: game.StatHygiene, activity: game.ActivityBathing,
+		sideEffects: []game.ItemEffect{{Stat: game.StatEnergy, Amount: -game.EnergyReduce}}}
+	PlayUse = ItemUse{verb: "play", stat: game.StatWellness, activity: game.ActivityPlaying, xp: game.ExperienceEarn,
+		sideEffects: []game.ItemEffect{{Stat: game.StatEnergy, Amount: -game.EnergyReduce}, {Stat: game.StatHygiene, Amount: -game.HygieneReduce}}}
+)
+
+// ItemUseResult is the outcome of using an item on a pet.
+type ItemUseResult struct {
+	PetId     types.EntityID
+	Deltas    component.StatDeltas // Changes applied when the item is used, the rest come when the activity ends
+	XP        int64                // Experience earned by the pet when the activity ends
+	Treatment component.Treatment
+	Activity  *component.Activity
+}
+
+/**
+ * ItemUseFor picks how an item is used from its kind and effects.
+ *
+ * Returns:
+ *   (ItemUse, error): How the item is used, and an error for items that cannot be used on a living pet.
+ */
+func ItemUseFor(item *component.Item, effects []game.ItemEffect) (ItemUse, error) {
+	switch {
+	case component.HasEffectOn(effects, game.EffectRevive):
+		return ItemUse{}, fmt.Errorf("failed to use item [%s can only revive a pet]", item.ItemName)
+	case item.Kind == component.ItemFood.String():
+		return FeedUse, nil
+	case item.Kind == component.ItemToy.String():
+		return PlayUse, nil
+	case component.HasEffectOn(effects, game.StatHygiene):
+		return BathUse, nil
+	default:
+		return CureUse, nil
+	}
+}
+
+/**
+ * UseItem uses one unit of an item on a pet (see `UseItems`).
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   personaTag (string): The persona tag of the player.
+ *   nickname (string): The nickname of the pet.
+ *   itemName (string): The name of the item.
+ *   use (ItemUse): How the item is used.
+ *
+ * Returns:
+ *   (ItemUseResult, error): The pet, the actual stat changes, the experience to earn, the treatment and its activity, and any error that occurs during the process.
+ */
+func UseItem(world cardinal.WorldContext, personaTag string, nickname string, itemName string, use ItemUse) (ItemUseResult, error) {
+	return UseItems(world, personaTag, nickname, itemName, use, 1)
+}
+
+/**
+ * UseItems is the code path shared by every action using an item on a pet, and by queued activities.
+ * Using several units at once adds up the effects of the item, while the action itself happens once.
+ *
+ * Code Flow:
+ * 1. Find the player and their living pet.
+ * 2. For uses starting an activity, check the pet is not currently engaged in an activity nor too sick for it.
+ * 3. For uses earning experience, check the pet is not at its max level.
+ * 4. Check the pet has more energy than the action spends.
+ * 5. Find the item among the player's items, check it has an effect on the stat of the use and the player owns
+ *    the quantity used (at most `game.MaxStackQuantity`).
+ * 6. Apply the side effects of the action, and the effects of the item times the quantity scaled for the pet's personality
+ *    (see `game.TraitCarePercent`), clamped to the stat ranges (see `component.ApplyPetEffects`). For uses starting
+ *    an activity, the positive effects of the item are held back until the activity ends, and prorated when it is
+ *    cancelled (see `component.ActivityRewards`). Drugs also treat the pet's disease (see `component.TreatPet`).
+ * 7. Start the activity of the use (see `component.StartPetActivityWith`), whose own effects are applied while it runs
+ *    and when it ends, with the experience scaled by the pet's mood (see `game.MoodLevel`) held back the same way.
+ * 8. Consume the units of the item used.
+ *
+ * Parameters:
+ *   world (cardinal.WorldContext): The world context.
+ *   personaTag (string): The persona tag of the player.
+ *   nickname (string): The nickname of the pet.
+ *   itemName (string): The name of the item.
+ *   use (ItemUse): How the item is used.
+ *   quantity (int): The number of units used at once.
+ *
+ * Returns:
+ *   (ItemUseResult, error): The pet, the actual stat changes, the experience to earn, the treatment and its activity, and any error that occurs during the process.
+ */
+func UseItems(world cardinal.WorldContext, personaTag string, nickname string, itemName string, use ItemUse, quantity int) (ItemUseResult, error) {
+	log := world.Logger()
+	if quantity <= 0 || quantity > game.MaxStackQuantity {
+		return ItemUseResult{}, fmt.Errorf("failed to %s [invalid quantity %d, at most %d]", use.verb, quantity, game.MaxStackQuantity)
+	}
+
+	// Step 1: Find the player and their living pet
+	playerID, err := component.FindPlayerByPersonaTag(world, personaTag)
+	if err != nil {
+		return ItemUseResult{}, err
+	}
+	player, err := cardinal.GetComponent[component.Player](world, playerID)
+	if err != nil {
+		return ItemUseResult{}, fmt.Errorf("failed to %s [get Player]: %w", use.verb, err)
+	}
+	petId, err := player.GetPetNickname(world, nickname)
+	if err != nil {
+		return ItemUseResult{}, err
+	}
+	if err := CheckPetAlive(world, petId); err != nil {
+		return ItemUseResult{}, err
+	}
+
+	// Step 2: Check the pet is idle
+	petActivity, err := component.GetPetActivity(world, petId)
+	if err != nil {
+		return ItemUseResult{}, err
+	}
+	if use.activity != "" && petActivity.CountDown > 0 {
+		return ItemUseResult{}, fmt.Errorf("pet is already engaged in an activity")
+	}
+	if use.activity != "" {
+		if err := CheckPetFitFor(world, petId, use.activity); err != nil {
+			return ItemUseResult{}, err
+		}
+	}
+
+	// Step 3: Check the pet can still grow
+	var pet *component.Pet
+	if use.xp > 0 {
+		if pet, err = cardinal.GetComponent[component.Pet](world, petId); err != nil {
+			return ItemUseResult{}, fmt.Errorf("failed to %s [get Pet]: %w", use.verb, err)
+		}
+		if pet.Level >= game.MaxLevel {
+			return ItemUseResult{}, fmt.Errorf("pet Max lvl, cant grow more")
+		}
+	}
+
+	// Step 4: Check the pet has the energy the action spends
+	energyCost := 0
+	for _, effect := range use.sideEffects {
+		if effect.Stat == game.StatEnergy && effect.Amount < 0 {
+			energyCost -= effect.Amount
+		}
+	}
+	if energyCost > 0 {
+		petEnergy, err := component.GetPetEnergy(world, petId)
+		if err != nil {
+			return ItemUseResult{}, err
+		}
+		if petEnergy.E-energyCost <= 0 {
+			return ItemUseResult{}, fmt.Errorf("pet energy is insufficient")
+		}
+	}
+
+	// Step 5: Find the item and check it suits the use
+	log.Info().Msgf("Use item: %s [%s]", use.verb, itemName)
+	itemId, err := player.GetItemIdByName(world, itemName)
+	if err != nil {
+		return ItemUseResult{}, err
+	}
+	itemEffects := component.GetItemEffects(world, itemId)
+	if !component.HasEffectOn(itemEffects, use.stat) {
+		return ItemUseResult{}, fmt.Errorf("failed to %s [%s has no %s effect]", use.verb, itemName, use.stat)
+	}
+	if owned := player.ItemQuantity(itemId); owned < quantity {
+		return ItemUseResult{}, fmt.Errorf("failed to %s [have %d %s, using %d]", use.verb, owned, itemName, quantity)
+	}
+
+	// Step 6: Apply the side effects of the action. The effects of an item used in an activity are held back
+	//         until it ends, so that cancelling it only gives the share of the ticks it ran
+	carePercent := game.TraitCarePercent(component.GetPetTraits(world, petId), use.stat)
+	effects := make([]game.ItemEffect, 0, len(itemEffects)+len(use.sideEffects))
+	var held []game.ItemEffect
+	for _, effect := range itemEffects {
+		effect.Amount *= quantity
+		if !effect.IsBuff() && effect.Amount > 0 {
+			effect.Amount = effect.Amount * carePercent / 100
+			if use.activity != "" {
+				held = append(held, effect)
+				continue
+			}
+		}
+		effects = append(effects, effect)
+	}
+	effects = append(effects, use.sideEffects...)
+	deltas, err := component.ApplyPetEffects(world, petId, itemName, effects)
+	if err != nil {
+		return ItemUseResult{}, err
+	}
+	treatment, err := component.TreatPet(world, petId, itemId)
+	if err != nil {
+		return ItemUseResult{}, err
+	}
+
+	// Step 7: Start the activity, holding back the item effects and the experience until it ends
+	var xp int64
+	if pet != nil {
+		xp = use.xp * int64(component.PetMoodLevel(world, petId).XPPercent) / 100
+	}
+	if use.activity != "" {
+		if petActivity, err = component.StartPetActivityWith(world, petId, use.activity, held, xp); err != nil {
+			return ItemUseResult{}, err
+		}
+	} else if err := component.GrantActivityXP(world, petId, game.InitialActivity, xp); err != nil {
+		return ItemUseResult{}, err
+	}
+
+	// Step 8: Consume the units used
+	if err := component.RemovePlayerItems(world, playerID, itemId, quantity); err != nil {
+		return ItemUseResult{}, err
+	}
+
+	return ItemUseResult{PetId: petId, Deltas: deltas, XP: xp, Treatment: treatment, Activity: petActivity}, nil
+}
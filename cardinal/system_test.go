@@ -15,18 +15,36 @@ import (
 )
 
 const (
-	createMsgName        = "game.create-pet"
-	createPlayerMsgName  = "game.create-player"
-	createPersonaMsgName = "persona.create-persona"
-	buyItemMsgName       = "game.buy-item"
-	playMsgName          = "game.play-pet"
-	sleepMsgName         = "game.sleep-pet"
-	bathMsgName          = "game.bath-pet"
-	eatMsgName           = "game.eat-pet"
-	breedMsgName         = "game.breed-pet"
-	personaTag           = "_test_persona"
-	signerAddress        = "0xa1D239A61908FaC55Ca95Cd112698623bD36bC4f"
-	petName              = "Manny"
+	createMsgName          = "game.create-pet"
+	createPlayerMsgName    = "game.create-player"
+	createPersonaMsgName   = "persona.create-persona"
+	buyItemMsgName         = "game.buy-item"
+	sellItemMsgName        = "game.sell-item"
+	playMsgName            = "game.play-pet"
+	cureMsgName            = "game.cure-pet"
+	sleepMsgName           = "game.sleep-pet"
+	bathMsgName            = "game.bath-pet"
+	eatMsgName             = "game.feed-pet"
+	useItemMsgName         = "game.use-item"
+	cancelActivityMsgName  = "game.cancel-activity"
+	enqueueActivityMsgName = "game.enqueue-activity"
+	clearQueueMsgName      = "game.clear-queue"
+	vacationModeMsgName    = "game.vacation-mode"
+	thinkPetMsgName        = "game.think-pet"
+	breedMsgName           = "game.breed-pet"
+	reviveMsgName          = "game.revive-pet"
+	trainSkillMsgName      = "game.train-skill"
+	practiceMagicMsgName   = "game.practice-magic"
+	proposeTradeMsgName    = "game.propose-trade"
+	acceptTradeMsgName     = "game.accept-trade"
+	cancelTradeMsgName     = "game.cancel-trade"
+	listForSaleMsgName     = "game.list-for-sale"
+	buyListingMsgName      = "game.buy-listing"
+	challengeMsgName       = "game.challenge-pet"
+	acceptChallengeMsgName = "game.accept-challenge"
+	personaTag             = "_test_persona"
+	signerAddress          = "0xa1D239A61908FaC55Ca95Cd112698623bD36bC4f"
+	petName                = "Manny"
 )
 
 // This function tests the creation of a pet.
@@ -138,7 +156,12 @@ func createPet(t *testing.T, tf *cardinal.TestFixture, petName string, personaTa
 		Nickname: petName,
 	}
 	_, err := executeTx[msg.CreatePetReply](t, tf, createMsgName, createMsg, personaTag)
-	return err
+	if err != nil {
+		return err
+	}
+	// The pet gets no personality traits whatever its random Dna, so its stats change at the usual rates.
+	setPetPersonality(t, tf, petName)
+	return nil
 }
 
 // This function creates a player.
@@ -217,3 +240,44 @@ func PetBathAction(t *testing.T, tf *cardinal.TestFixture, nickName string, item
 	_, err := executeTx[msg.BathPetMsgReply](t, tf, bathMsgName, petBathMsg, personaTag)
 	return err
 }
+
+// This function breeds two pets.
+// Flow:
+// 1. Get the message type for breeding pets.
+// 2. Add the transaction to the test fixture.
+// 3. Verify that the pet was bred successfully.
+func PetBreedAction(t *testing.T, tf *cardinal.TestFixture, motherName string, fatherName string, bornName string) error {
+	// Preconditions:
+	// - The test fixture is initialized.
+	petBreedMsg := msg.BreedPetMsg{
+		MotherName: motherName,
+		FatherName: fatherName,
+		BornName:   bornName,
+	}
+	_, err := executeTx[msg.BreedPetMsgReply](t, tf, breedMsgName, petBreedMsg, personaTag)
+	return err
+}
+
+// This function moves a pet to the given life stage.
+// Flow:
+// 1. Find the pet by its nickname.
+// 2. Overwrite its LifeStage component.
+func setPetLifeStage(t *testing.T, tf *cardinal.TestFixture, nickName string, stage string) {
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, nickName)
+	assert.NoError(t, err)
+	err = cardinal.SetComponent(wCtx, petId, &component.LifeStage{Stage: stage, Since: wCtx.CurrentTick()})
+	assert.NoError(t, err)
+}
+
+// This function replaces the personality traits of a pet.
+// Flow:
+// 1. Find the pet by its nickname.
+// 2. Overwrite its Personality component.
+func setPetPersonality(t *testing.T, tf *cardinal.TestFixture, nickName string, traits ...string) {
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, nickName)
+	assert.NoError(t, err)
+	err = cardinal.SetComponent(wCtx, petId, &component.Personality{Traits: traits})
+	assert.NoError(t, err)
+}
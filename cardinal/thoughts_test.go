@@ -0,0 +1,164 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+// TestThought_MostUrgentNeedWins tests that the most urgent thought wins whatever the order of the stats,
+// and that the texts vary with the traits of the pet.
+func TestThought_MostUrgentNeedWins(t *testing.T) {
+	// Given:
+	// - A hungry pet that is also a bit dirty and down, in the morning.
+	state := game.ThoughtState{
+		Stats: map[string]int{game.StatHealth: 100, game.StatEnergy: 100, game.StatHygiene: 50, game.StatWellness: 50, game.StatSatiety: 10},
+		Hour:  game.DayStartHour,
+	}
+
+	// When / Then:
+	// - Hunger is the most pressing need.
+	rule, ok := game.UrgentThought(state)
+	assert.True(t, ok)
+	assert.Equal(t, game.StatSatiety, rule.Need)
+
+	// - A pet close to death thinks about its health first.
+	state.Stats[game.StatHealth] = 10
+	rule, _ = game.UrgentThought(state)
+	assert.Equal(t, game.StatHealth, rule.Need)
+
+	// - Something that just happened beats mild needs, but not pressing ones.
+	state.Stats[game.StatHealth] = 100
+	state.Event = game.ActivityEvent(game.EventCompleted, game.ActivityEating)
+	rule, _ = game.UrgentThought(state)
+	assert.Equal(t, game.StatSatiety, rule.Need)
+	state.Stats[game.StatSatiety] = 100
+	rule, _ = game.UrgentThought(state)
+	assert.Equal(t, game.ThoughtEvent, rule.Need)
+
+	// - Without needs nor events, the pet thinks about the time of day.
+	state.Event = ""
+	state.Stats[game.StatHygiene] = 100
+	state.Stats[game.StatWellness] = 100
+	rule, _ = game.UrgentThought(state)
+	assert.Equal(t, game.ThoughtTime, rule.Need)
+
+	// - Babies have their own way of saying they are hungry, and templates name the pet and its owner.
+	state.Stats[game.StatSatiety] = 10
+	rule, _ = game.UrgentThought(state)
+	texts := rule.TextsFor([]string{game.StageBaby})
+	assert.Equal(t, "Hungwy! Manny wants food!", game.FormatThought(texts[0], petName, personaTag))
+}
+
+// TestSystem_ThinkSystem_RecordsThoughts tests that idle pets think about their most urgent need and what just
+// happened to them, and that the pet-thoughts query returns their last thoughts.
+func TestSystem_ThinkSystem_RecordsThoughts(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a starving, dirty pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: 10}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hygiene{Hy: 10}))
+
+	// When:
+	// - A think cycle runs.
+	for i := 0; i < game.ThinkTickRate; i++ {
+		tf.DoTick()
+	}
+
+	// Then:
+	// - The pet thinks about food, its most urgent need.
+	thoughts, err := query.QueryPetThoughts(wCtx, &query.PetThoughtsRequest{Nickname: petName})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, thoughts.Thoughts)
+	assert.Equal(t, game.StatSatiety, thoughts.Thoughts[0].Need)
+	assert.Equal(t, thoughts.Thoughts[0].Text, thoughts.Think)
+
+	// When:
+	// - The pet is fed and clean, then woken up from a nap, and a think cycle runs.
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: game.MaxSatiety}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hygiene{Hy: game.MaxHygiene}))
+	_, err = executeTx[msg.SleepPetMsgReply](t, tf, sleepMsgName, msg.SleepPetMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+	_, err = executeTx[msg.CancelActivityMsgReply](t, tf, cancelActivityMsgName,
+		msg.CancelActivityMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+	for i := 0; i < game.ThinkTickRate; i++ {
+		tf.DoTick()
+	}
+
+	// Then:
+	// - The pet complains about its owner, and remembers falling asleep before that.
+	thoughts, err = query.QueryPetThoughts(wCtx, &query.PetThoughtsRequest{Nickname: petName, Limit: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, "Grr... "+personaTag+" woke me up.", thoughts.Think)
+	assert.Len(t, thoughts.Thoughts, 2)
+	assert.Equal(t, game.ThoughtEvent, thoughts.Thoughts[0].Need)
+	assert.Equal(t, game.ThinkSleep, thoughts.Thoughts[1].Text)
+	assert.Greater(t, thoughts.Thoughts[0].Tick, thoughts.Thoughts[1].Tick)
+}
+
+// TestSystem_PetThinkAction_ExplainsNeed tests that asking a pet what it thinks explains its most pressing need,
+// recommends a store item for it and gives the pet a little wellness, once per cooldown.
+func TestSystem_PetThinkAction_ExplainsNeed(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - Two players are created, one of them with a starving pet.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	createPersona(t, tf, traderTag)
+	createPlayer(t, tf, traderTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: 10}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Wellness{Wn: 50}))
+
+	// When:
+	// - The owner asks the pet what it thinks.
+	reply, err := executeTx[msg.ThinkPetMsgReply](t, tf, thinkPetMsgName, msg.ThinkPetMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet explains it is starving and the most filling food is recommended.
+	assert.Equal(t, game.StatSatiety, reply.Need)
+	assert.Equal(t, 10, reply.Value)
+	assert.Equal(t, petName+" is starving, feed it right away.", reply.Explanation)
+	assert.Equal(t, foodName, reply.Item)
+	assert.Equal(t, game.StoreFood, reply.Store)
+
+	// - The attention gives the pet a little wellness.
+	assert.Equal(t, game.ThinkPetWellness, reply.Wellness)
+	wellness, err := cardinal.GetComponent[component.Wellness](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 50+game.ThinkPetWellness, wellness.Wn)
+
+	// When / Then:
+	// - Asking again right away gives no more wellness.
+	again, err := executeTx[msg.ThinkPetMsgReply](t, tf, thinkPetMsgName, msg.ThinkPetMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+	assert.Zero(t, again.Wellness)
+	assert.Equal(t, reply.NextBonusTick, again.NextBonusTick)
+
+	// - Only the owner can ask the pet.
+	_, err = executeTx[msg.ThinkPetMsgReply](t, tf, thinkPetMsgName, msg.ThinkPetMsg{TargetNickname: petName}, traderTag)
+	assert.Error(t, err)
+}
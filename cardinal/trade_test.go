@@ -0,0 +1,220 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+	"pkg.world.dev/world-engine/cardinal/types"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+	"tamagotchi/query"
+)
+
+const traderTag = "_test_trader"
+
+// proposePetForMoney makes personaTag offer petName and one unit of food to traderTag for 3 coins.
+func proposePetForMoney(t *testing.T, tf *cardinal.TestFixture) types.EntityID {
+	reply, err := executeTx[msg.ProposeTradeMsgReply](t, tf, proposeTradeMsgName, msg.ProposeTradeMsg{
+		Recipient: traderTag,
+		Offer:     msg.TradeAssets{Items: []msg.TradeItem{{Name: foodName, Quantity: 1}}, Pets: []string{petName}},
+		Request:   msg.TradeAssets{Money: 3 * game.Coin},
+	}, personaTag)
+	assert.NoError(t, err)
+	return reply.TradeID
+}
+
+// setupTraders creates two players, the first owning a pet and two units of food.
+func setupTraders(t *testing.T, tf *cardinal.TestFixture) {
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	createPersona(t, tf, traderTag)
+	createPlayer(t, tf, traderTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	_, err := executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: foodName, Quantity: 2}, personaTag)
+	assert.NoError(t, err)
+}
+
+// TestSystem_Trade_Accept tests that an accepted trade swaps the escrowed offer with the requested money.
+func TestSystem_Trade_Accept(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - Two players are created, the first owning a pet and two units of food.
+	setupTraders(t, tf)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	before, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+
+	// - The first player offers the pet and a unit of food for money.
+	tradeId := proposePetForMoney(t, tf)
+
+	// - The offer is held in escrow and listed for both players.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.False(t, player.HasPet(petId))
+	itemId, err := component.FindItemByName(wCtx, foodName)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, player.ItemQuantity(itemId))
+
+	pending, err := query.QueryPendingTrades(wCtx, &query.PendingTradesRequest{PersonaTag: traderTag})
+	assert.NoError(t, err)
+	assert.Empty(t, pending.Outgoing)
+	assert.Len(t, pending.Incoming, 1)
+	assert.Equal(t, []string{petName}, pending.Incoming[0].Offer.Pets)
+
+	// When:
+	// - The second player accepts the trade.
+	_, err = executeTx[msg.AcceptTradeMsgReply](t, tf, acceptTradeMsgName, msg.AcceptTradeMsg{TradeID: tradeId}, traderTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet and the food moved to the second player.
+	trader, err := component.GetPlayerByPersonaTag(wCtx, traderTag)
+	assert.NoError(t, err)
+	assert.True(t, trader.HasPet(petId))
+	assert.Equal(t, 1, trader.ItemQuantity(itemId))
+	pet, err := cardinal.GetComponent[component.Pet](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, traderTag, pet.PersonaTag)
+
+	// - The money moved to the first player.
+	player, err = component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, before.Money+3*game.Coin, player.Money)
+	assert.Equal(t, game.PlayerInitialMoney-3*game.Coin, trader.Money)
+
+	// - The trade is no longer pending.
+	pending, err = query.QueryPendingTrades(wCtx, &query.PendingTradesRequest{PersonaTag: personaTag})
+	assert.NoError(t, err)
+	assert.Empty(t, pending.Outgoing)
+}
+
+// TestSystem_Trade_Cancel tests that a cancelled trade returns the offer and that only its parties can cancel it.
+func TestSystem_Trade_Cancel(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - Two players are created and the first offers an adult pet and food to the second.
+	setupTraders(t, tf)
+	setPetLifeStage(t, tf, petName, game.StageAdult)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	tradeId := proposePetForMoney(t, tf)
+
+	// When:
+	// - The pet held in escrow is bred, then the recipient cancels the trade.
+	breedErr := PetBreedAction(t, tf, petName, petName, childName)
+	_, err = executeTx[msg.CancelTradeMsgReply](t, tf, cancelTradeMsgName, msg.CancelTradeMsg{TradeID: tradeId}, traderTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The escrowed pet could not be used.
+	assert.Error(t, breedErr)
+	assert.Contains(t, breedErr.Error(), "held in a trade")
+
+	// - The offer is back with the first player.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.True(t, player.HasPet(petId))
+	itemId, err := component.FindItemByName(wCtx, foodName)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, player.ItemQuantity(itemId))
+
+	// - The trade can no longer be accepted.
+	_, err = executeTx[msg.AcceptTradeMsgReply](t, tf, acceptTradeMsgName, msg.AcceptTradeMsg{TradeID: tradeId}, traderTag)
+	assert.Error(t, err)
+}
+
+// TestSystem_Trade_Expires tests that an offer not accepted in time is returned to the proposer.
+func TestSystem_Trade_Expires(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - Two players are created and the first offers a pet and food to the second.
+	setupTraders(t, tf)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	tradeId := proposePetForMoney(t, tf)
+
+	// - The trade is about to expire.
+	trade, err := component.GetTrade(wCtx, tradeId)
+	assert.NoError(t, err)
+	trade.ExpiresTick = tf.World.CurrentTick()
+	assert.NoError(t, cardinal.SetComponent(wCtx, tradeId, trade))
+
+	// When:
+	// - A tick passes.
+	tf.DoTick()
+
+	// Then:
+	// - The offer is back with the first player and the trade is gone.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.True(t, player.HasPet(petId))
+	_, err = component.GetTrade(wCtx, tradeId)
+	assert.Error(t, err)
+	itemId, err := component.FindItemByName(wCtx, foodName)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, player.ItemQuantity(itemId))
+}
+
+// TestSystem_Trade_ExpiresWithPetFrozen tests that a pet held by a trade keeps its stats until the trade expires,
+// even when it would have died of neglect meanwhile.
+func TestSystem_Trade_ExpiresWithPetFrozen(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - Two players are created, and the pet of the first is dirty and about to die.
+	setupTraders(t, tf)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Health{HP: 5}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hygiene{Hy: 0}))
+
+	// - The first player offers the pet, the trade expiring after many decline cycles.
+	tradeId := proposePetForMoney(t, tf)
+	activity, err := component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.ActivityEscrow, activity.Activity)
+	trade, err := component.GetTrade(wCtx, tradeId)
+	assert.NoError(t, err)
+	trade.ExpiresTick = tf.World.CurrentTick() + 20*game.DeclineTickRate
+	assert.NoError(t, cardinal.SetComponent(wCtx, tradeId, trade))
+
+	// When:
+	// - The trade expires.
+	for i := 0; i <= 20*game.DeclineTickRate; i++ {
+		tf.DoTick()
+	}
+	_, err = component.GetTrade(wCtx, tradeId)
+	assert.Error(t, err)
+
+	// Then:
+	// - The pet is back with the first player, alive, free and with the stats it had when it was offered.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.True(t, player.HasPet(petId))
+	assert.False(t, component.IsPetDeceased(wCtx, petId))
+	health, err := cardinal.GetComponent[component.Health](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, health.HP)
+	activity, err = component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.InitialActivity, activity.Activity)
+}
@@ -0,0 +1,177 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+const buffItemName = "Vitamin"
+
+// This function uses an item on a pet.
+// Flow:
+// 1. Build the use item message for the pet and item.
+// 2. Execute the transaction and return its reply.
+func PetUseItemAction(t *testing.T, tf *cardinal.TestFixture, nickName string, itemName string) (*msg.UseItemMsgReply, error) {
+	useMsg := msg.UseItemMsg{
+		TargetNickname: nickName,
+		ItemName:       itemName,
+	}
+	return executeTx[msg.UseItemMsgReply](t, tf, useItemMsgName, useMsg, personaTag)
+}
+
+// TestSystem_PetUseItemAction_ActualDeltas tests that using food replies with the actual change of each stat, and that
+// the food's effects are clamped when the meal ends.
+func TestSystem_PetUseItemAction_ActualDeltas(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and a nearly full pet are created, and the player buys food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: game.MaxSatiety - 10}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Energy{E: game.MaxEnergy}))
+	assert.NoError(t, buyToy(t, tf, foodName))
+
+	// When:
+	// - The food is used on the pet.
+	reply, err := PetUseItemAction(t, tf, petName, foodName)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The pet is eating, and the food's effects wait for the meal to end.
+	assert.Equal(t, 0, reply.Satiety)
+	assert.Equal(t, 0, reply.Energy)
+	assert.Equal(t, "Eating", reply.Activity)
+
+	// - The satiety only rose to its maximum once the meal ended.
+	endActivity(t, tf, petName)
+	hunger, err := component.GetPetHunger(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.MaxSatiety, hunger.Satiety)
+
+	// - The food was consumed.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	_, err = player.GetItemByName(wCtx, foodName)
+	assert.Error(t, err)
+}
+
+// TestSystem_PetUseItemAction_Quantity tests that using several units of food adds up their effects and consumes them all.
+func TestSystem_PetUseItemAction_Quantity(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and a hungry pet are created, and the player buys three units of food.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: 10}))
+	_, err = executeTx[msg.BuyItemMsgReply](t, tf, buyItemMsgName, msg.ButItemMsg{Name: foodName, Quantity: 3}, personaTag)
+	assert.NoError(t, err)
+
+	// When:
+	// - More units than the player owns are used, then two units.
+	_, tooManyErr := executeTx[msg.UseItemMsgReply](t, tf, useItemMsgName,
+		msg.UseItemMsg{TargetNickname: petName, ItemName: foodName, Quantity: 4}, personaTag)
+	reply, err := executeTx[msg.UseItemMsgReply](t, tf, useItemMsgName,
+		msg.UseItemMsg{TargetNickname: petName, ItemName: foodName, Quantity: 2}, personaTag)
+	assert.NoError(t, err)
+
+	// Then:
+	// - Using more units than owned is refused.
+	assert.Error(t, tooManyErr)
+
+	// - The pet eats once, and its satiety rises by the satiety of both units when the meal ends.
+	assert.Equal(t, "Eating", reply.Activity)
+	endActivity(t, tf, petName)
+	hunger, err := component.GetPetHunger(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 10+2*catalogItem(t, foodName).Effect(game.StatSatiety), hunger.Satiety)
+
+	// - Both units were consumed.
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, player.ItemQuantity(findItem(t, wCtx, foodName)))
+}
+
+// TestSystem_PetUseItemAction_Buff tests that an item with a buff keeps applying it every buff cycle.
+func TestSystem_PetUseItemAction_Buff(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and a hurt pet are created, and the player buys a vitamin.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Health{HP: 50}))
+	assert.NoError(t, buyToy(t, tf, buffItemName))
+
+	// When:
+	// - The vitamin is used on the pet.
+	reply, err := PetUseItemAction(t, tf, petName, buffItemName)
+	assert.NoError(t, err)
+
+	// Then:
+	// - The instant effect is applied and the buff is active.
+	assert.Equal(t, catalogItem(t, buffItemName).Effect(game.StatHealth), reply.Health)
+	buffs, ok := component.GetPetBuffs(wCtx, petId)
+	assert.True(t, ok)
+	assert.Len(t, buffs.Active, 1)
+	assert.Equal(t, buffItemName, buffs.Active[0].Source)
+
+	// - The buff heals the pet once every buff cycle.
+	health, err := cardinal.GetComponent[component.Health](wCtx, petId)
+	assert.NoError(t, err)
+	before := health.HP
+	for i := 0; i < game.BuffTickRate; i++ {
+		tf.DoTick()
+	}
+	health, err = cardinal.GetComponent[component.Health](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, before+buffs.Active[0].Amount, health.HP)
+}
+
+// TestSystem_PetUseItemAction_ReviveItem tests that revive items cannot be used on a living pet.
+func TestSystem_PetUseItemAction_ReviveItem(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and pet are created, and the player buys a revive item.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	assert.NoError(t, buyToy(t, tf, reviveItemName))
+
+	// When:
+	// - The revive item is used on the living pet.
+	_, err := PetUseItemAction(t, tf, petName, reviveItemName)
+
+	// Then:
+	// - The use is rejected.
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "can only revive a pet")
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"pkg.world.dev/world-engine/cardinal"
+
+	"tamagotchi/component"
+	"tamagotchi/game"
+	"tamagotchi/msg"
+)
+
+// This function sends a pet away while its owner is away.
+// Flow:
+// 1. Build the vacation mode message for the pet, mode and number of hours.
+// 2. Execute the transaction and return its reply.
+func vacationMode(t *testing.T, tf *cardinal.TestFixture, nickName string, mode string, hours int) (*msg.VacationModeMsgReply, error) {
+	vacationMsg := msg.VacationModeMsg{
+		TargetNickname: nickName,
+		Mode:           mode,
+		Hours:          hours,
+	}
+	return executeTx[msg.VacationModeMsgReply](t, tf, vacationModeMsgName, vacationMsg, personaTag)
+}
+
+// setPetStats sets the energy, hygiene, wellness and satiety of a pet.
+func setPetStats(t *testing.T, tf *cardinal.TestFixture, nickName string, value int) {
+	wCtx := cardinal.NewWorldContext(tf.World)
+	petId, _, err := component.GetPetByNickname(wCtx, nickName)
+	assert.NoError(t, err)
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Energy{E: value}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hygiene{Hy: value}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Wellness{Wn: value}))
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, &component.Hunger{Satiety: value}))
+}
+
+// TestSystem_VacationModeAction_FreezesDecline tests that the stats of a pet on vacation do not decline,
+// that its owner is charged for it and that the pet can be brought home early.
+func TestSystem_VacationModeAction_FreezesDecline(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewReadOnlyWorldContext(tf.World)
+
+	// - A persona, player and an adult pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	setPetLifeStage(t, tf, petName, game.StageAdult)
+	setPetStats(t, tf, petName, 50)
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	before, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+
+	// When:
+	// - The pet goes on vacation for a day, and a few decline cycles run.
+	reply, err := vacationMode(t, tf, petName, game.VacationModeVacation, 24)
+	assert.NoError(t, err)
+	for i := 0; i < 4*game.DeclineTickRate; i++ {
+		tf.DoTick()
+	}
+
+	// Then:
+	// - The pet is away for a day and the first hour is charged to the player.
+	assert.Equal(t, game.ActivityVacation, reply.Activity)
+	assert.Equal(t, 24*game.TickHour, reply.Duration)
+	assert.Equal(t, game.VacationHourlyCost, reply.HourlyCost)
+	player, err := component.GetPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, before.Money-game.VacationHourlyCost, player.Money)
+	playerId, err := component.FindPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	entries := component.GetPlayerLedger(wCtx, playerId).Entries
+	last := entries[len(entries)-1]
+	assert.Equal(t, game.LedgerVacation, last.Reason)
+	assert.Equal(t, petName, last.Reference)
+	assert.Equal(t, -game.VacationHourlyCost, last.Amount)
+
+	// - The pet's stats did not decline.
+	energy, err := component.GetPetEnergy(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, energy.E)
+	hygiene, err := cardinal.GetComponent[component.Hygiene](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, hygiene.Hy)
+	wellness, err := cardinal.GetComponent[component.Wellness](wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, 50, wellness.Wn)
+
+	// - The pet can be brought home early, and is busy until then.
+	_, err = executeTx[msg.SleepPetMsgReply](t, tf, sleepMsgName, msg.SleepPetMsg{TargetNickname: petName}, personaTag)
+	assert.Error(t, err)
+	cancelled, err := executeTx[msg.CancelActivityMsgReply](t, tf, cancelActivityMsgName,
+		msg.CancelActivityMsg{TargetNickname: petName}, personaTag)
+	assert.NoError(t, err)
+	assert.Equal(t, game.ActivityVacation, cancelled.Activity)
+	activity, err := component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.InitialActivity, activity.Activity)
+}
+
+// TestSystem_VacationModeAction_DaycareReturns tests that a pet at the daycare is charged every hour
+// and returns on its own, or early when its owner cannot pay.
+func TestSystem_VacationModeAction_DaycareReturns(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+	wCtx := cardinal.NewWorldContext(tf.World)
+
+	// - A persona, player and a pet are created, and the pet goes to the daycare for two hours.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+	petId, _, err := component.GetPetByNickname(wCtx, petName)
+	assert.NoError(t, err)
+	playerId, err := component.FindPlayerByPersonaTag(wCtx, personaTag)
+	assert.NoError(t, err)
+	before, err := cardinal.GetComponent[component.Player](wCtx, playerId)
+	assert.NoError(t, err)
+	_, err = vacationMode(t, tf, petName, game.VacationModeDaycare, 2)
+	assert.NoError(t, err)
+
+	// When:
+	// - The second hour starts.
+	activity, err := component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.ActivityDaycare, activity.Activity)
+	activity.CountDown = game.TickHour + 1
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, activity))
+	tf.DoTick()
+
+	// Then:
+	// - Both hours were charged.
+	player, err := cardinal.GetComponent[component.Player](wCtx, playerId)
+	assert.NoError(t, err)
+	assert.Equal(t, before.Money-2*game.DaycareHourlyCost, player.Money)
+
+	// - The pet returns at the end of its stay.
+	endActivity(t, tf, petName)
+	activity, err = component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.InitialActivity, activity.Activity)
+
+	// When:
+	// - The pet goes back to the daycare, and its owner runs out of money before the second hour.
+	_, err = vacationMode(t, tf, petName, game.VacationModeDaycare, 2)
+	assert.NoError(t, err)
+	player, err = cardinal.GetComponent[component.Player](wCtx, playerId)
+	assert.NoError(t, err)
+	player.Money = 0
+	assert.NoError(t, cardinal.SetComponent(wCtx, playerId, player))
+	activity, err = component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	activity.CountDown = game.TickHour + 1
+	assert.NoError(t, cardinal.SetComponent(wCtx, petId, activity))
+	tf.DoTick()
+
+	// Then:
+	// - The pet is brought home early.
+	activity, err = component.GetPetActivity(wCtx, petId)
+	assert.NoError(t, err)
+	assert.Equal(t, game.InitialActivity, activity.Activity)
+}
+
+// TestSystem_VacationModeAction_Rejected tests that a pet is only parked with a known mode and a valid duration.
+func TestSystem_VacationModeAction_Rejected(t *testing.T) {
+	// Given:
+	// - A test fixture is initialized.
+	tf := cardinal.NewTestFixture(t, nil)
+	MustInitWorld(tf.World)
+
+	// - A persona, player and a pet are created.
+	createPersona(t, tf, personaTag)
+	createPlayer(t, tf, personaTag)
+	assert.NoError(t, createPet(t, tf, petName, personaTag))
+
+	// When / Then:
+	// - Unknown modes are rejected.
+	_, err := vacationMode(t, tf, petName, "cruise", 1)
+	assert.ErrorContains(t, err, "unknown mode")
+
+	// - The stay lasts at least an hour and at most `game.MaxVacationHours`.
+	_, err = vacationMode(t, tf, petName, game.VacationModeVacation, 0)
+	assert.Error(t, err)
+	_, err = vacationMode(t, tf, petName, game.VacationModeVacation, game.MaxVacationHours+1)
+	assert.Error(t, err)
+}
@@ -2,5 +2,6 @@ export interface Item {
 	name: string
 	kind: string
 	description:string
+	// Price in minor units, 10000 per coin.
 	price: number
 }
\ No newline at end of file
@@ -5,4 +5,105 @@ export interface Pet {
 	lvl:number
 	exp:number
 	NextLevelXP: number
+	born_tick: number
+}
+
+export interface PetActivity {
+	Activity: string
+	TotalTicks: number
+	CountDown: number
+	Percentage: number
+}
+
+export interface PetDna {
+	A: number
+	C: number
+	G: number
+	T: number
+}
+
+// PetImmunity protects a pet against a class of diseases until it expires.
+export interface PetImmunity {
+	class: string
+	expires_tick: number
+}
+
+// PetMood sums up how a pet is doing, e.g. to pick its face sprite.
+export interface PetMood {
+	// "ecstatic", "happy", "neutral", "sad" or "miserable"
+	mood: string
+	score: number
+	since: number
+}
+
+// PetAbility is the Magic or Skill of a pet.
+export interface PetAbility {
+	Kind: string
+	lvl: number
+	exp: number
+	NextLevelXP: number
+}
+
+export interface GeneContribution {
+	gene: string
+	father: number
+	mother: number
+	mutation: number
+	value: number
+}
+
+export interface PetLineage {
+	father_id: number
+	mother_id: number
+	father: string
+	mother: string
+	genes: GeneContribution[]
+}
+
+export interface PetDeceased {
+	cause: string
+	died_tick: number
+}
+
+export interface QueuedActivity {
+	action: string
+	item_name: string
+	queued_tick: number
+}
+
+export interface ThoughtEntry {
+	tick: number
+	// The stat the thought is about, or "time", "event" or "activity"
+	need: string
+	text: string
+}
+
+export interface PetBuff {
+	source: string
+	stat: string
+	amount: number
+	expires_tick: number
+}
+
+// PetStatus holds every component of a pet, optional components are null when the pet does not have them.
+export interface PetStatus {
+	id: number
+	pet: Pet
+	health: number
+	energy: number
+	hygiene: number
+	wellness: number
+	satiety: number
+	stage: string
+	mood: PetMood | null
+	activity: PetActivity | null
+	think: string
+	dna: PetDna | null
+	// Personality traits: "playful", "lazy", "clean" or "glutton"
+	traits: string[] | null
+	magic: PetAbility | null
+	skill: PetAbility | null
+	lineage: PetLineage | null
+	deceased: PetDeceased | null
+	buffs: PetBuff[] | null
 }
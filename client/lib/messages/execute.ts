@@ -57,6 +57,8 @@ export interface FeedPetMsg {
 
 export interface FeedPetMsgReply {
   health: number;
+  energy: number;
+  satiety: number;
   activity: string;
   duration: number;
 }
@@ -70,6 +72,7 @@ export interface PlayPetMsgReply {
   energy: number;
   hygiene: number;
   wellness: number;
+  xp: number;
   activity: string;
   duration: number;
 }
@@ -81,6 +84,10 @@ export interface CurePetMsg {
 
 export interface CurePetMsgReply {
 	health: number
+	// The disease the drug cured, empty if it cured none
+	cured: string
+	// The classes of diseases the pet is now immune to
+	prevents: string[] | null
 }
 
 export interface SleepPetMsg {
@@ -99,12 +106,178 @@ export interface ThinkPetMsg {
 
 export interface ThinkPetMsgReply {
   think: string;
+  // The stat the pet needs most, empty when it needs nothing
+  need: string;
+  value: number;
+  explanation: string;
+  // The recommended item and the store selling it: foodstore, drugstore or toystore
+  item: string;
+  store: string;
+  wellness: number;
+  next_bonus_tick: number;
 }
 
 export interface ButItemMsg {
 	name: string
+	quantity?: number
 }
 
 export interface BuyItemMsgReply {
 	success: boolean
+	quantity: number
+}
+
+export interface SellItemMsg {
+	name: string
+	quantity?: number
+}
+
+export interface SellItemMsgReply {
+	success: boolean
+	earned: number
+	quantity: number
+}
+
+export interface TradeItem {
+	name: string
+	quantity: number
+}
+
+export interface TradeAssets {
+	items?: TradeItem[]
+	money?: number
+	pets?: string[]
+}
+
+export interface ProposeTradeMsg {
+	recipient: string
+	offer: TradeAssets
+	request: TradeAssets
+}
+
+export interface ProposeTradeMsgReply {
+	trade_id: number
+	expires_tick: number
+}
+
+export interface AcceptTradeMsg {
+	trade_id: number
+}
+
+export interface CancelTradeMsg {
+	trade_id: number
+}
+
+export interface ListForSaleMsg {
+	item_name?: string
+	quantity?: number
+	pet_name?: string
+	price: number
+}
+
+export interface ListForSaleMsgReply {
+	listing_id: number
+	expires_tick: number
+}
+
+export interface BuyListingMsg {
+	listing_id: number
+}
+
+export interface BuyListingMsgReply {
+	success: boolean
+	paid: number
+}
+
+export interface ChallengePetMsg {
+	pet: string
+	opponent: string
+}
+
+export interface ChallengePetMsgReply {
+	battle_id: number
+	expires_tick: number
+}
+
+export interface AcceptChallengeMsg {
+	battle_id: number
+}
+
+export interface TrainSkillMsg {
+	target: string
+}
+
+export interface PracticeMagicMsg {
+	target: string
+}
+
+export interface AbilityMsgReply {
+	kind: string
+	lvl: number
+	exp: number
+	next_lvl_exp: number
+	energy: number
+	activity: string
+	duration: number
+}
+
+export interface UseItemMsg {
+	target: string
+	item_name: string
+}
+
+export interface UseItemMsgReply {
+	health: number
+	energy: number
+	hygiene: number
+	wellness: number
+	satiety: number
+	activity: string
+	duration: number
+}
+
+export interface CancelActivityMsg {
+	target: string
+}
+
+export interface CancelActivityMsgReply {
+	activity: string
+	elapsed: number
+	health: number
+	energy: number
+	hygiene: number
+	wellness: number
+	satiety: number
+}
+
+export interface EnqueueActivityMsg {
+	target: string
+	action: string // sleep, feed, bath or play
+	item_name: string
+}
+
+export interface EnqueueActivityMsgReply {
+	position: number
+}
+
+export interface ClearQueueMsg {
+	target: string
+}
+
+export interface ClearQueueMsgReply {
+	cleared: number
+}
+
+export interface VacationModeMsg {
+	target: string
+	// "vacation" freezes the stats of the pet, "daycare" slows their decline for a lower cost
+	mode: string
+	hours: number
+}
+
+export interface VacationModeMsgReply {
+	activity: string
+	duration: number
+	return_tick: number
+	hourly_cost: number
 }
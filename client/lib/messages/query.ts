@@ -1,5 +1,5 @@
 import type { Item } from "../entity/item";
-import type { Pet } from "../entity/pet";
+import type { Pet, PetActivity, PetImmunity, PetStatus, QueuedActivity, ThoughtEntry } from "../entity/pet";
 
 export interface RpcFindMatchRequest {
     fast: boolean;
@@ -36,6 +36,81 @@ export interface PetHealthResponse {
 	HP: number
 }
 
+export interface PetStatusRequest {
+	nickname: string
+}
+
+export interface PetStatusResponse {
+	status: PetStatus
+}
+
+export interface PlayerPetsRequest {
+	personaTag: string
+}
+
+export interface PlayerPetsResponse {
+	// The status of each pet of the player, in the order of the player's pets.
+	pets: PetStatus[]
+}
+
+export interface PetQueueRequest {
+	nickname: string
+}
+
+export interface PetQueueResponse {
+	activity: PetActivity
+	// The actions waiting to start, next first.
+	queue: QueuedActivity[]
+	max_length: number
+}
+
+export interface PetThoughtsRequest {
+	nickname: string
+	// Every remembered thought when zero
+	limit: number
+}
+
+export interface PetThoughtsResponse {
+	think: string
+	// Newest first
+	thoughts: ThoughtEntry[]
+}
+
+export interface WorldClockRequest {}
+
+export interface WorldClockResponse {
+	tick: number
+	day: number
+	hour: number
+	minute: number
+	// "day" or "night"
+	phase: string
+	ticks_to_next_phase: number
+	is_night: boolean
+}
+
+export interface PetDiagnosisRequest {
+	nickname: string
+}
+
+export interface PetDiagnosisResponse {
+	sick: boolean
+	disease: string
+	// "viral", "bacterial" or "parasitic"
+	class: string
+	severity: number
+	max_severity: number
+	since: number
+	symptoms: string[]
+	// The activities the pet is too sick for
+	blocks: string[]
+	// The drugs curing the disease
+	cures: string[]
+	immunities: PetImmunity[]
+	// Percentage of chance of a healthy pet to fall sick every minute
+	risk: number
+}
+
 export interface PetsRequest {}
 
 export interface PetsResponse {
@@ -47,10 +122,15 @@ export interface PlayerItemsMsg {
 	personaTag: string
 }
 
+// InventoryItem is an item owned by the player and how many units of it they own.
+export interface InventoryItem extends Item {
+	quantity: number
+}
+
 // ItemListReply represents the response to a player items query.
 export interface PlayerItemsResponse  {
-	// The list of items belonging to the player.
-	items: Item[]
+	// The list of items belonging to the player, one entry per item.
+	items: InventoryItem[]
 }
 
 export interface LeaderboardMsg {}
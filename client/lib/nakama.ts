@@ -8,34 +8,63 @@ import {
 import {
   type LeaderboardMsg,
   type LeaderboardReply,
+  type PetDiagnosisRequest,
+  type PetDiagnosisResponse,
   type PetEnergyRequest,
   type PetEnergyResponse,
   type PetHealthRequest,
   type PetHealthResponse,
+  type PetQueueRequest,
+  type PetQueueResponse,
+  type PetThoughtsRequest,
+  type PetThoughtsResponse,
+  type WorldClockRequest,
+  type WorldClockResponse,
+  type PetStatusRequest,
+  type PetStatusResponse,
   type PetsRequest,
   type PetsResponse,
   type PlayerExistMsg,
   type PlayerExistReply,
   type PlayerItemsMsg,
   type PlayerItemsResponse,
+  type PlayerPetsRequest,
+  type PlayerPetsResponse,
+  type InventoryItem,
   type RpcCurrentTickResponse,
   type RpcFindPersonaResponse,
 } from "./messages/query";
 import type {
+  AcceptChallengeMsg,
+  AcceptTradeMsg,
   BathPetMsg,
   BreedPetMsg,
   ButItemMsg,
+  BuyListingMsg,
+  CancelActivityMsg,
+  CancelTradeMsg,
+  ChallengePetMsg,
+  ClearQueueMsg,
   CreatePetMsg,
   CreatePlayerMsg,
+  EnqueueActivityMsg,
   FeedPetMsg,
+  ListForSaleMsg,
   PlayPetMsg,
+  PracticeMagicMsg,
+  ProposeTradeMsg,
   Receipt,
   ReceiptsResponse,
+  SellItemMsg,
   SleepPetMsg,
+  ThinkPetMsg,
+  TradeAssets,
+  TrainSkillMsg,
   TxResponse,
+  UseItemMsg,
+  VacationModeMsg,
 } from "./messages/execute";
-import type { Pet } from "./entity/pet";
-import type { Item } from "./entity/item";
+import type { Pet, PetStatus } from "./entity/pet";
 import { udpSocket } from "bun";
 
 class GameState {
@@ -453,15 +482,15 @@ class Nakama {
     }
   }
 
-  async buyItem(name: string): Promise<TxResponse | undefined> {
+  async buyItem(name: string, quantity: number = 1): Promise<TxResponse | undefined> {
     if (!this.socket || !this.session) {
       console.log("Socket or session not found");
       return;
     }
     try {
 
-        console.log(`Buying item [${name}]`)
-        const data: ButItemMsg = { name: name };
+        console.log(`Buying item [${name}] x${quantity}`)
+        const data: ButItemMsg = { name: name, quantity: quantity };
         console.log(`${JSON.stringify(data)}`);
 
         const result = await this.client.rpc(
@@ -478,6 +507,431 @@ class Nakama {
     }
   }
 
+  async sellItem(name: string, quantity: number = 1): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Selling item [${name}] x${quantity}`)
+        const data: SellItemMsg = { name: name, quantity: quantity };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/sell-item",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async proposeTrade(recipient: string, offer: TradeAssets, request: TradeAssets): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Proposing trade to [${recipient}]`)
+        const data: ProposeTradeMsg = { recipient: recipient, offer: offer, request: request };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/propose-trade",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async acceptTrade(tradeId: number): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Accepting trade [${tradeId}]`)
+        const data: AcceptTradeMsg = { trade_id: tradeId };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/accept-trade",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async cancelTrade(tradeId: number): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Cancelling trade [${tradeId}]`)
+        const data: CancelTradeMsg = { trade_id: tradeId };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/cancel-trade",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async listForSale(itemName: string, quantity: number, price: number): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Listing [${itemName}] x${quantity} for ${price}`)
+        const data: ListForSaleMsg = { item_name: itemName, quantity: quantity, price: price };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/list-for-sale",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async listPetForSale(petName: string, price: number): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Listing pet [${petName}] for ${price}`)
+        const data: ListForSaleMsg = { pet_name: petName, price: price };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/list-for-sale",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async buyListing(listingId: number): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Buying listing [${listingId}]`)
+        const data: BuyListingMsg = { listing_id: listingId };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/buy-listing",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async challengePet(petName: string, opponentName: string): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Challenging [${opponentName}] with [${petName}]`)
+        const data: ChallengePetMsg = { pet: petName, opponent: opponentName };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/challenge-pet",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async acceptChallenge(battleId: number): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Accepting challenge [${battleId}]`)
+        const data: AcceptChallengeMsg = { battle_id: battleId };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/accept-challenge",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async trainSkill(name: string): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Training pet [${name}]`)
+        const data: TrainSkillMsg = { target: name };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/train-skill",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async practiceMagic(name: string): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Practicing magic with pet [${name}]`)
+        const data: PracticeMagicMsg = { target: name };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/practice-magic",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async useItem(target: string, itemName: string): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Using item [${itemName}] on pet [${target}]`)
+        const data: UseItemMsg = { target: target, item_name: itemName };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/use-item",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async cancelActivity(target: string): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Cancelling activity of pet [${target}]`)
+        const data: CancelActivityMsg = { target: target };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/cancel-activity",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async enqueueActivity(target: string, action: string, itemName: string): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Queuing [${action}] on pet [${target}]`)
+        const data: EnqueueActivityMsg = { target: target, action: action, item_name: itemName };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/enqueue-activity",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async clearQueue(target: string): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Clearing queue of pet [${target}]`)
+        const data: ClearQueueMsg = { target: target };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/clear-queue",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async vacationMode(target: string, mode: string, hours: number): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Sending pet [${target}] to ${mode} for ${hours} hours`)
+        const data: VacationModeMsg = { target: target, mode: mode, hours: hours };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/vacation-mode",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async thinkPet(target: string): Promise<TxResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    try {
+
+        console.log(`Asking pet [${target}] what it thinks`)
+        const data: ThinkPetMsg = { target: target };
+        console.log(`${JSON.stringify(data)}`);
+
+        const result = await this.client.rpc(
+          this.session,
+          "tx/game/think-pet",
+          data
+        );
+        console.log(`${JSON.stringify(result)}`);
+        const txResponse = result.payload! as TxResponse;
+        return txResponse;
+
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
   async getReceipts(startTick: number): Promise<ReceiptsResponse | undefined > {
     const options = {
       method: "POST",
@@ -557,6 +1011,132 @@ class Nakama {
     }
   }
 
+  async queryPetStatus(nickname: string): Promise<PetStatus | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    const data: PetStatusRequest = {
+      nickname: nickname,
+    };
+    try {
+      const result: RpcResponse = await this.client.rpc(
+        this.session,
+        "query/game/pet-status",
+        data
+      );
+      console.log(`${JSON.stringify(result)}`);
+      const statusResponse = result.payload! as PetStatusResponse;
+      return statusResponse.status;
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async queryPlayerPets(personaTag: string): Promise<PetStatus[] | undefined> {
+    console.log(`queryPlayerPets [${personaTag}]`)
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    const data: PlayerPetsRequest = {personaTag: personaTag};
+    try {
+      const result: RpcResponse = await this.client.rpc(
+        this.session,
+        "query/game/player-pets",
+        data
+      );
+      console.log(`queryPlayerPets [${JSON.stringify(result)}]`);
+      const petsResponse = result.payload! as PlayerPetsResponse;
+      return petsResponse.pets;
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async queryPetQueue(nickname: string): Promise<PetQueueResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    const data: PetQueueRequest = {
+      nickname: nickname,
+    };
+    try {
+      const result: RpcResponse = await this.client.rpc(
+        this.session,
+        "query/game/pet-queue",
+        data
+      );
+      console.log(`${JSON.stringify(result)}`);
+      return result.payload! as PetQueueResponse;
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async queryPetThoughts(nickname: string, limit: number): Promise<PetThoughtsResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    const data: PetThoughtsRequest = {
+      nickname: nickname,
+      limit: limit,
+    };
+    try {
+      const result: RpcResponse = await this.client.rpc(
+        this.session,
+        "query/game/pet-thoughts",
+        data
+      );
+      console.log(`${JSON.stringify(result)}`);
+      return result.payload! as PetThoughtsResponse;
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async queryPetDiagnosis(nickname: string): Promise<PetDiagnosisResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    const data: PetDiagnosisRequest = {
+      nickname: nickname,
+    };
+    try {
+      const result: RpcResponse = await this.client.rpc(
+        this.session,
+        "query/game/pet-diagnosis",
+        data
+      );
+      console.log(`${JSON.stringify(result)}`);
+      return result.payload! as PetDiagnosisResponse;
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
+  async queryWorldClock(): Promise<WorldClockResponse | undefined> {
+    if (!this.socket || !this.session) {
+      console.log("Socket or session not found");
+      return;
+    }
+    const data: WorldClockRequest = {};
+    try {
+      const result: RpcResponse = await this.client.rpc(
+        this.session,
+        "query/game/world-clock",
+        data
+      );
+      console.log(`${JSON.stringify(result)}`);
+      return result.payload! as WorldClockResponse;
+    } catch (error) {
+      console.error("Unknown error occurred", error);
+    }
+  }
+
   async queryPets(): Promise<Pet[] | undefined> {
     if (!this.socket || !this.session) {
       console.log("Socket or session not found");
@@ -602,7 +1182,7 @@ class Nakama {
     }
   }
 
-  async queryPlayerItems(personaTag: string): Promise<Item[] | undefined> {
+  async queryPlayerItems(personaTag: string): Promise<InventoryItem[] | undefined> {
     console.log(`queryPlayerItems [${personaTag}]`)
     if (!this.socket || !this.session) {
       console.log("Socket or session not found");